@@ -11,6 +11,9 @@ import (
 
 // EnableBasicAuth enables basic authentication for the application.
 //
+// The authentication applies to both the HTTP and gRPC servers, for which the credentials are read from the
+// authorization metadata of the calls. It must be enabled before registering the gRPC services.
+//
 // It takes a variable number of credentials as alternating username and password strings.
 // An error is logged if an odd number of arguments is provided.
 func (a *App) EnableBasicAuth(credentials ...string) {
@@ -30,7 +33,7 @@ func (a *App) EnableBasicAuth(credentials ...string) {
 		users[credentials[i]] = credentials[i+1]
 	}
 
-	a.httpServer.router.Use(middleware.BasicAuthMiddleware(middleware.BasicAuthProvider{Users: users}))
+	a.enableAuth(&middleware.BasicAuthProvider{Users: users})
 }
 
 // EnableBasicAuthWithFunc enables basic authentication for the HTTP server with a custom validation function.
@@ -38,7 +41,7 @@ func (a *App) EnableBasicAuth(credentials ...string) {
 // Deprecated: This method is deprecated and will be removed in future releases, users must use
 // [App.EnableBasicAuthWithValidator] as it has access to application datasources.
 func (a *App) EnableBasicAuthWithFunc(validateFunc func(username, password string) bool) {
-	a.httpServer.router.Use(middleware.BasicAuthMiddleware(middleware.BasicAuthProvider{ValidateFunc: validateFunc, Container: a.container}))
+	a.enableAuth(&middleware.BasicAuthProvider{ValidateFunc: validateFunc, Container: a.container})
 }
 
 // EnableBasicAuthWithValidator enables basic authentication for the HTTP server with a custom validator.
@@ -46,15 +49,14 @@ func (a *App) EnableBasicAuthWithFunc(validateFunc func(username, password strin
 // The provided `validateFunc` is invoked for each authentication attempt. It receives a container instance,
 // username, and password. The function should return `true` if the credentials are valid, `false` otherwise.
 func (a *App) EnableBasicAuthWithValidator(validateFunc func(c *container.Container, username, password string) bool) {
-	a.httpServer.router.Use(middleware.BasicAuthMiddleware(middleware.BasicAuthProvider{
-		ValidateFuncWithDatasources: validateFunc, Container: a.container}))
+	a.enableAuth(&middleware.BasicAuthProvider{ValidateFuncWithDatasources: validateFunc, Container: a.container})
 }
 
 // EnableAPIKeyAuth enables API key authentication for the application.
 //
 // It requires at least one API key to be provided. The provided API keys will be used to authenticate requests.
 func (a *App) EnableAPIKeyAuth(apiKeys ...string) {
-	a.httpServer.router.Use(middleware.APIKeyAuthMiddleware(middleware.APIKeyAuthProvider{}, apiKeys...))
+	a.enableAuth(&middleware.APIKeyAuthProvider{APIKeys: apiKeys})
 }
 
 // EnableAPIKeyAuthWithFunc enables API key authentication for the application with a custom validation function.
@@ -62,10 +64,10 @@ func (a *App) EnableAPIKeyAuth(apiKeys ...string) {
 // Deprecated: This method is deprecated and will be removed in future releases, users must use
 // [App.EnableAPIKeyAuthWithValidator] as it has access to application datasources.
 func (a *App) EnableAPIKeyAuthWithFunc(validateFunc func(apiKey string) bool) {
-	a.httpServer.router.Use(middleware.APIKeyAuthMiddleware(middleware.APIKeyAuthProvider{
+	a.enableAuth(&middleware.APIKeyAuthProvider{
 		ValidateFunc: validateFunc,
 		Container:    a.container,
-	}))
+	})
 }
 
 // EnableAPIKeyAuthWithValidator enables API key authentication for the application with a custom validation function.
@@ -73,10 +75,10 @@ func (a *App) EnableAPIKeyAuthWithFunc(validateFunc func(apiKey string) bool) {
 // The provided `validateFunc` is used to determine the validity of an API key. It receives the request container
 // and the API key as arguments and should return `true` if the key is valid, `false` otherwise.
 func (a *App) EnableAPIKeyAuthWithValidator(validateFunc func(c *container.Container, apiKey string) bool) {
-	a.httpServer.router.Use(middleware.APIKeyAuthMiddleware(middleware.APIKeyAuthProvider{
+	a.enableAuth(&middleware.APIKeyAuthProvider{
 		ValidateFuncWithDatasources: validateFunc,
 		Container:                   a.container,
-	}))
+	})
 }
 
 // EnableOAuth configures OAuth middleware for the application.
@@ -100,5 +102,14 @@ func (a *App) EnableOAuth(jwksEndpoint string,
 		RefreshInterval: time.Second * time.Duration(refreshInterval),
 	}
 
-	a.httpServer.router.Use(middleware.OAuth(middleware.NewOAuth(oauthOption), options...))
+	a.enableAuth(middleware.NewOAuthProviderWithKeys(middleware.NewOAuth(oauthOption), options...))
+}
+
+// enableAuth authenticates the requests to the HTTP server and the calls to the gRPC server with the provider.
+func (a *App) enableAuth(provider middleware.AuthProvider) {
+	a.httpServer.router.Use(middleware.AuthMiddleware(provider))
+
+	if a.grpcServer != nil {
+		a.grpcServer.addAuthProvider(provider)
+	}
 }
@@ -20,13 +20,18 @@ import (
 	"time"
 
 	_ "github.com/go-sql-driver/mysql" // This is required to be blank import
+	"google.golang.org/grpc"
 
 	"gofr.dev/pkg/gofr/config"
+	"gofr.dev/pkg/gofr/datasource"
 	"gofr.dev/pkg/gofr/datasource/file"
 	"gofr.dev/pkg/gofr/datasource/pubsub"
+	"gofr.dev/pkg/gofr/datasource/pubsub/amqp"
 	"gofr.dev/pkg/gofr/datasource/pubsub/google"
 	"gofr.dev/pkg/gofr/datasource/pubsub/kafka"
+	"gofr.dev/pkg/gofr/datasource/pubsub/memory"
 	"gofr.dev/pkg/gofr/datasource/pubsub/mqtt"
+	redisPubSub "gofr.dev/pkg/gofr/datasource/pubsub/redis"
 	"gofr.dev/pkg/gofr/datasource/redis"
 	"gofr.dev/pkg/gofr/datasource/sql"
 	"gofr.dev/pkg/gofr/logging"
@@ -38,6 +43,14 @@ import (
 	"gofr.dev/pkg/gofr/websocket"
 )
 
+// GRPCClient is a connection to a gRPC server, to be passed to the constructors of the generated gRPC clients.
+type GRPCClient interface {
+	grpc.ClientConnInterface
+
+	HealthCheck(ctx context.Context) *datasource.Health
+	Close() error
+}
+
 // Container is a collection of all common application level concerns. Things like Logger, Connection Pool for Redis
 // etc. which is shared across is placed here.
 type Container struct {
@@ -47,6 +60,7 @@ type Container struct {
 	appVersion string
 
 	Services       map[string]service.HTTP
+	GRPCClients    map[string]GRPCClient
 	metricsManager metrics.Manager
 	PubSub         pubsub.Client
 
@@ -169,6 +183,17 @@ func (c *Container) Create(conf config.Config) {
 		}, c.Logger, c.metricsManager)
 	case "MQTT":
 		c.PubSub = c.createMqttPubSub(conf)
+	case "REDIS":
+		c.PubSub = c.createRedisPubSub(conf)
+	case "AMQP":
+		c.PubSub = c.createAMQPPubSub(conf)
+	case "MEMORY":
+		maxMessages, _ := strconv.Atoi(conf.Get("PUBSUB_MEMORY_MAX_MESSAGES"))
+
+		c.PubSub = memory.New(memory.Config{
+			ConsumerGroupID: conf.Get("CONSUMER_ID"),
+			MaxMessages:     maxMessages,
+		}, c.Logger, c.metricsManager)
 	}
 
 	c.File = file.NewLocalFileSystem(c.Logger)
@@ -191,6 +216,10 @@ func (c *Container) Close() error {
 		err = errors.Join(err, c.PubSub.Close())
 	}
 
+	for _, client := range c.GRPCClients {
+		err = errors.Join(err, client.Close())
+	}
+
 	for _, conn := range c.WSManager.ListConnections() {
 		c.WSManager.CloseConnection(conn)
 	}
@@ -198,6 +227,50 @@ func (c *Container) Close() error {
 	return err
 }
 
+// createAMQPPubSub returns the pub/sub client of the AMQP broker, e.g. RabbitMQ.
+func (c *Container) createAMQPPubSub(conf config.Config) pubsub.Client {
+	prefetch, _ := strconv.Atoi(conf.Get("AMQP_PREFETCH"))
+	confirmTimeout, _ := time.ParseDuration(conf.Get("AMQP_CONFIRM_TIMEOUT"))
+	retryTimeout, _ := time.ParseDuration(conf.Get("AMQP_RETRY_TIMEOUT"))
+
+	client := amqp.New(amqp.Config{
+		URL:             conf.Get("AMQP_URL"),
+		Exchange:        conf.Get("AMQP_EXCHANGE"),
+		ExchangeType:    conf.Get("AMQP_EXCHANGE_TYPE"),
+		ConsumerGroupID: conf.Get("CONSUMER_ID"),
+		Prefetch:        prefetch,
+		Requeue:         conf.Get("AMQP_REQUEUE") == "true",
+		ConfirmTimeout:  confirmTimeout,
+		RetryTimeout:    retryTimeout,
+	}, c.Logger, c.metricsManager)
+	if client == nil {
+		return nil
+	}
+
+	return client
+}
+
+// createRedisPubSub returns the pub/sub client on the streams of the Redis datasource, which must be configured.
+func (c *Container) createRedisPubSub(conf config.Config) pubsub.Client {
+	if isNil(c.Redis) {
+		c.Logger.Errorf("redis pubsub requires the redis datasource, set REDIS_HOST to configure it")
+
+		return nil
+	}
+
+	maxLen, _ := strconv.ParseInt(conf.Get("PUBSUB_REDIS_MAX_LEN"), 10, 64)
+	blockTimeout, _ := time.ParseDuration(conf.Get("PUBSUB_REDIS_BLOCK_TIMEOUT"))
+	claimMinIdle, _ := time.ParseDuration(conf.Get("PUBSUB_REDIS_CLAIM_MIN_IDLE"))
+
+	return redisPubSub.New(c.Redis, redisPubSub.Config{
+		ConsumerGroupID: conf.Get("CONSUMER_ID"),
+		ConsumerName:    conf.Get("PUBSUB_REDIS_CONSUMER_NAME"),
+		MaxLen:          maxLen,
+		BlockTimeout:    blockTimeout,
+		ClaimMinIdle:    claimMinIdle,
+	}, c.Logger, c.metricsManager)
+}
+
 func (c *Container) createMqttPubSub(conf config.Config) pubsub.Client {
 	var qos byte
 
@@ -205,6 +278,7 @@ func (c *Container) createMqttPubSub(conf config.Config) pubsub.Client {
 	order, _ := strconv.ParseBool(conf.GetOrDefault("MQTT_MESSAGE_ORDER", "false"))
 
 	retrieveRetained, _ := strconv.ParseBool(conf.GetOrDefault("MQTT_RETRIEVE_RETAINED", "false"))
+	protocolVersion, _ := strconv.Atoi(conf.Get("MQTT_PROTOCOL_VERSION"))
 
 	keepAlive, err := time.ParseDuration(conf.Get("MQTT_KEEP_ALIVE"))
 	if err != nil {
@@ -234,6 +308,7 @@ func (c *Container) createMqttPubSub(conf config.Config) pubsub.Client {
 		RetrieveRetained: retrieveRetained,
 		KeepAlive:        keepAlive,
 		CloseTimeout:     0 * time.Millisecond,
+		ProtocolVersion:  protocolVersion,
 	}
 
 	return mqtt.New(configs, c.Logger, c.metricsManager)
@@ -245,6 +320,12 @@ func (c *Container) GetHTTPService(serviceName string) service.HTTP {
 	return c.Services[serviceName]
 }
 
+// GetGRPCClient returns registered gRPC clients.
+// gRPC clients are registered from AddGRPCClient method of GoFr object.
+func (c *Container) GetGRPCClient(name string) GRPCClient {
+	return c.GRPCClients[name]
+}
+
 func (c *Container) Metrics() metrics.Manager {
 	return c.metricsManager
 }
@@ -262,6 +343,10 @@ func (c *Container) registerFrameworkMetrics() {
 		httpBuckets := []float64{.001, .003, .005, .01, .02, .03, .05, .1, .2, .3, .5, .75, 1, 2, 3, 5, 10, 30}
 		c.Metrics().NewHistogram("app_http_response", "Response time of HTTP requests in seconds.", httpBuckets...)
 		c.Metrics().NewHistogram("app_http_service_response", "Response time of HTTP service requests in seconds.", httpBuckets...)
+		c.Metrics().NewCounter("app_http_service_rejected_count", "Number of HTTP service requests rejected by bulkhead or rate limiter.")
+		c.Metrics().NewUpDownCounter("app_http_service_in_flight", "Number of in-flight HTTP service requests guarded by a bulkhead.")
+		c.Metrics().NewCounter("app_http_service_cache_count", "Number of HTTP service GET requests by cache result.")
+		c.Metrics().NewCounter("app_http_service_hedge_count", "Number of hedged HTTP service requests sent and won.")
 	}
 
 	{ // Redis metrics
@@ -281,6 +366,19 @@ func (c *Container) registerFrameworkMetrics() {
 	c.Metrics().NewCounter("app_pubsub_publish_success_count", "Number of successful publish operations.")
 	c.Metrics().NewCounter("app_pubsub_subscribe_total_count", "Number of total subscribe operations.")
 	c.Metrics().NewCounter("app_pubsub_subscribe_success_count", "Number of successful subscribe operations.")
+	c.Metrics().NewCounter("app_pubsub_subscribe_retry_count", "Number of retries of the subscription handlers.")
+	c.Metrics().NewCounter("app_pubsub_dead_letter_count", "Number of messages published to the dead-letter topics.")
+	c.Metrics().NewCounter("app_pubsub_duplicate_count", "Number of duplicate messages skipped by the subscriptions.")
+	c.Metrics().NewCounter("app_pubsub_commit_failure_count", "Number of messages failed to be committed to the broker.")
+	c.Metrics().NewUpDownCounter("app_pubsub_subscribe_in_flight", "Number of messages being processed by the subscriptions.")
+	c.Metrics().NewHistogram("app_pubsub_subscribe_duration", "Time taken by the subscriptions to process messages in seconds.",
+		.001, .003, .005, .01, .02, .03, .05, .1, .2, .3, .5, .75, 1, 2, 3, 5, 10, 30)
+	c.Metrics().NewGauge("app_pubsub_consumer_lag", "Number of messages of the topics yet to be consumed.")
+	c.Metrics().NewGauge("app_pubsub_consumer_lag_seconds", "Time since the last consumed messages were published in seconds.")
+	c.Metrics().NewCounter("app_outbox_published_count", "Number of outbox events published by the relay.")
+	c.Metrics().NewCounter("app_outbox_publish_error_count", "Number of outbox events failed to be published by the relay.")
+	c.Metrics().NewCounter("app_outbox_dead_letter_count", "Number of outbox events published to the dead-letter topic by the relay.")
+	c.Metrics().NewCounter("app_outbox_parked_count", "Number of outbox events parked by the relay after their last attempt.")
 }
 
 func (c *Container) GetAppName() string {
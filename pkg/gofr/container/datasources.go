@@ -383,6 +383,13 @@ type PubSubProvider interface {
 	provider
 }
 
+// OptionsPubSubProvider is the PubSubProvider which publishes the messages with headers, a key and a content type,
+// as the pub/sub clients of GoFr do. It is mocked by MockOptionsPubSubProvider.
+type OptionsPubSubProvider interface {
+	PubSubProvider
+	pubsub.OptionsPublisher
+}
+
 type Solr interface {
 	Search(ctx context.Context, collection string, params map[string]any) (any, error)
 	Create(ctx context.Context, collection string, document *bytes.Buffer, params map[string]any) (any, error)
@@ -51,6 +51,15 @@ func (c *Container) Health(ctx context.Context) any {
 		healthMap[name] = health
 	}
 
+	for name, client := range c.GRPCClients {
+		health := client.HealthCheck(ctx)
+		if health.Status == statusDown {
+			downCount++
+		}
+
+		healthMap[name] = health
+	}
+
 	c.appHealth(healthMap, downCount)
 
 	return healthMap
@@ -209,6 +209,10 @@ func (*MockPubSub) Publish(_ context.Context, _ string, _ []byte) error {
 	return nil
 }
 
+func (*MockPubSub) PublishWithOptions(_ context.Context, _ string, _ []byte, _ pubsub.PublishOptions) error {
+	return nil
+}
+
 func (*MockPubSub) Subscribe(_ context.Context, _ string) (*pubsub.Message, error) {
 	return nil, nil
 }
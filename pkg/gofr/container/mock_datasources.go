@@ -11495,6 +11495,197 @@ func (mr *MockPubSubProviderMockRecorder) UseTracer(tracer any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseTracer", reflect.TypeOf((*MockPubSubProvider)(nil).UseTracer), tracer)
 }
 
+// MockOptionsPubSubProvider is a mock of OptionsPubSubProvider interface.
+type MockOptionsPubSubProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockOptionsPubSubProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockOptionsPubSubProviderMockRecorder is the mock recorder for MockOptionsPubSubProvider.
+type MockOptionsPubSubProviderMockRecorder struct {
+	mock *MockOptionsPubSubProvider
+}
+
+// NewMockOptionsPubSubProvider creates a new mock instance.
+func NewMockOptionsPubSubProvider(ctrl *gomock.Controller) *MockOptionsPubSubProvider {
+	mock := &MockOptionsPubSubProvider{ctrl: ctrl}
+	mock.recorder = &MockOptionsPubSubProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOptionsPubSubProvider) EXPECT() *MockOptionsPubSubProviderMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockOptionsPubSubProvider) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockOptionsPubSubProviderMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockOptionsPubSubProvider)(nil).Close))
+}
+
+// Connect mocks base method.
+func (m *MockOptionsPubSubProvider) Connect() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Connect")
+}
+
+// Connect indicates an expected call of Connect.
+func (mr *MockOptionsPubSubProviderMockRecorder) Connect() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connect", reflect.TypeOf((*MockOptionsPubSubProvider)(nil).Connect))
+}
+
+// CreateTopic mocks base method.
+func (m *MockOptionsPubSubProvider) CreateTopic(arg0 context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTopic", arg0, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTopic indicates an expected call of CreateTopic.
+func (mr *MockOptionsPubSubProviderMockRecorder) CreateTopic(arg0, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTopic", reflect.TypeOf((*MockOptionsPubSubProvider)(nil).CreateTopic), arg0, name)
+}
+
+// DeleteTopic mocks base method.
+func (m *MockOptionsPubSubProvider) DeleteTopic(arg0 context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTopic", arg0, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTopic indicates an expected call of DeleteTopic.
+func (mr *MockOptionsPubSubProviderMockRecorder) DeleteTopic(arg0, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTopic", reflect.TypeOf((*MockOptionsPubSubProvider)(nil).DeleteTopic), arg0, name)
+}
+
+// Health mocks base method.
+func (m *MockOptionsPubSubProvider) Health() datasource.Health {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Health")
+	ret0, _ := ret[0].(datasource.Health)
+	return ret0
+}
+
+// Health indicates an expected call of Health.
+func (mr *MockOptionsPubSubProviderMockRecorder) Health() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Health", reflect.TypeOf((*MockOptionsPubSubProvider)(nil).Health))
+}
+
+// Publish mocks base method.
+func (m *MockOptionsPubSubProvider) Publish(ctx context.Context, topic string, message []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, topic, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockOptionsPubSubProviderMockRecorder) Publish(ctx, topic, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockOptionsPubSubProvider)(nil).Publish), ctx, topic, message)
+}
+
+// PublishWithOptions mocks base method.
+func (m *MockOptionsPubSubProvider) PublishWithOptions(ctx context.Context, topic string, message []byte, options pubsub.PublishOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishWithOptions", ctx, topic, message, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishWithOptions indicates an expected call of PublishWithOptions.
+func (mr *MockOptionsPubSubProviderMockRecorder) PublishWithOptions(ctx, topic, message, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishWithOptions", reflect.TypeOf((*MockOptionsPubSubProvider)(nil).PublishWithOptions), ctx, topic, message, options)
+}
+
+// Query mocks base method.
+func (m *MockOptionsPubSubProvider) Query(ctx context.Context, query string, args ...any) ([]byte, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, query}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Query", varargs...)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Query indicates an expected call of Query.
+func (mr *MockOptionsPubSubProviderMockRecorder) Query(ctx, query any, args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, query}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockOptionsPubSubProvider)(nil).Query), varargs...)
+}
+
+// Subscribe mocks base method.
+func (m *MockOptionsPubSubProvider) Subscribe(ctx context.Context, topic string) (*pubsub.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, topic)
+	ret0, _ := ret[0].(*pubsub.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockOptionsPubSubProviderMockRecorder) Subscribe(ctx, topic any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockOptionsPubSubProvider)(nil).Subscribe), ctx, topic)
+}
+
+// UseLogger mocks base method.
+func (m *MockOptionsPubSubProvider) UseLogger(logger any) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UseLogger", logger)
+}
+
+// UseLogger indicates an expected call of UseLogger.
+func (mr *MockOptionsPubSubProviderMockRecorder) UseLogger(logger any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseLogger", reflect.TypeOf((*MockOptionsPubSubProvider)(nil).UseLogger), logger)
+}
+
+// UseMetrics mocks base method.
+func (m *MockOptionsPubSubProvider) UseMetrics(metrics any) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UseMetrics", metrics)
+}
+
+// UseMetrics indicates an expected call of UseMetrics.
+func (mr *MockOptionsPubSubProviderMockRecorder) UseMetrics(metrics any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseMetrics", reflect.TypeOf((*MockOptionsPubSubProvider)(nil).UseMetrics), metrics)
+}
+
+// UseTracer mocks base method.
+func (m *MockOptionsPubSubProvider) UseTracer(tracer any) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UseTracer", tracer)
+}
+
+// UseTracer indicates an expected call of UseTracer.
+func (mr *MockOptionsPubSubProviderMockRecorder) UseTracer(tracer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseTracer", reflect.TypeOf((*MockOptionsPubSubProvider)(nil).UseTracer), tracer)
+}
+
 // MockSolr is a mock of Solr interface.
 type MockSolr struct {
 	ctrl     *gomock.Controller
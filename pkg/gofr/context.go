@@ -11,6 +11,7 @@ import (
 
 	"gofr.dev/pkg/gofr/cmd/terminal"
 	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/datasource/pubsub"
 	"gofr.dev/pkg/gofr/http/middleware"
 	"gofr.dev/pkg/gofr/logging"
 )
@@ -150,6 +151,16 @@ func (a *authInfo) GetAPIKey() string {
 	return a.apiKey
 }
 
+// MessageHeaders returns the headers of the message handled by a subscriber, i.e. its headers, attributes or
+// properties as per the pub/sub backend. It returns nil when the handler is not serving a pub/sub message.
+func (c *Context) MessageHeaders() map[string]string {
+	if msg, ok := c.Request.(*pubsub.Message); ok {
+		return msg.Headers()
+	}
+
+	return nil
+}
+
 // func (c *Context) reset(w Responder, r Request) {
 //	c.Request = r
 //	c.responder = w
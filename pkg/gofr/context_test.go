@@ -20,6 +20,7 @@ import (
 
 	"gofr.dev/pkg/gofr/config"
 	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/datasource/pubsub"
 	gofrHTTP "gofr.dev/pkg/gofr/http"
 	"gofr.dev/pkg/gofr/http/middleware"
 	"gofr.dev/pkg/gofr/logging"
@@ -336,6 +337,19 @@ func TestGetAuthInfo_JWTClaims(t *testing.T) {
 	assert.Equal(t, claims, res)
 }
 
+func TestContext_MessageHeaders(t *testing.T) {
+	msg := pubsub.NewMessage(t.Context())
+	msg.SetHeaders(map[string]string{"tenant": "gofr"})
+
+	c := &Context{Context: t.Context(), Request: msg}
+
+	assert.Equal(t, map[string]string{"tenant": "gofr"}, c.MessageHeaders())
+
+	c.Request = gofrHTTP.NewRequest(httptest.NewRequest(http.MethodGet, "/", http.NoBody))
+
+	assert.Nil(t, c.MessageHeaders())
+}
+
 func TestContext_GetCorrelationID(t *testing.T) {
 	// Setup OpenTelemetry tracer
 	exporter := tracetest.NewInMemoryExporter()
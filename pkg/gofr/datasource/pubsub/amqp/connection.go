@@ -0,0 +1,136 @@
+package amqp
+
+import (
+	"context"
+	"time"
+
+	amqp091 "github.com/rabbitmq/amqp091-go"
+)
+
+// connection adapts the connection of the AMQP library to the Connection interface.
+type connection struct {
+	*amqp091.Connection
+}
+
+func dial(url string) (Connection, error) {
+	conn, err := amqp091.Dial(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return &connection{Connection: conn}, nil
+}
+
+func (c *connection) Channel() (Channel, error) {
+	ch, err := c.Connection.Channel()
+	if err != nil {
+		return nil, err
+	}
+
+	return &channel{Channel: ch}, nil
+}
+
+// channel adapts the channel of the AMQP library to the Channel interface.
+type channel struct {
+	*amqp091.Channel
+}
+
+func (c *channel) PublishWithDeferredConfirmWithContext(ctx context.Context, exchange, key string, mandatory, immediate bool,
+	msg amqp091.Publishing) (Confirmation, error) {
+	confirmation, err := c.Channel.PublishWithDeferredConfirmWithContext(ctx, exchange, key, mandatory, immediate, msg)
+	if err != nil || confirmation == nil {
+		return nil, err
+	}
+
+	return confirmation, nil
+}
+
+// connect connects to the broker, opening the channel on which the messages are published and declaring the exchange.
+// The client reconnects when the connection is lost.
+func (c *Client) connect() error {
+	conn, err := c.dial(c.config.URL)
+	if err != nil {
+		return err
+	}
+
+	ch, err := conn.Channel()
+	if err != nil {
+		_ = conn.Close()
+
+		return err
+	}
+
+	if err = ch.Confirm(false); err != nil {
+		_ = conn.Close()
+
+		return err
+	}
+
+	if err = ch.ExchangeDeclare(c.config.Exchange, c.config.ExchangeType, true, false, false, false, nil); err != nil {
+		_ = conn.Close()
+
+		return err
+	}
+
+	c.mu.Lock()
+
+	if c.closed {
+		c.mu.Unlock()
+
+		return conn.Close()
+	}
+
+	c.conn = conn
+	c.publisher = ch
+	c.consumers = make(map[string]*consumer)
+	c.mu.Unlock()
+
+	c.logger.Logf("connected to AMQP broker at '%s'", c.host)
+
+	go c.watch(conn)
+
+	return nil
+}
+
+// watch reconnects to the broker when the connection is closed, unless it is closed by Close.
+func (c *Client) watch(conn Connection) {
+	err, ok := <-conn.NotifyClose(make(chan *amqp091.Error, 1))
+
+	c.mu.Lock()
+
+	if c.closed {
+		c.mu.Unlock()
+
+		return
+	}
+
+	c.conn, c.publisher, c.consumers = nil, nil, nil
+
+	c.mu.Unlock()
+
+	if ok {
+		c.logger.Errorf("connection to AMQP broker at '%s' lost, error: %v", c.host, err)
+	} else {
+		c.logger.Errorf("connection to AMQP broker at '%s' closed", c.host)
+	}
+
+	c.retryConnect()
+}
+
+// retryConnect connects to the broker every RetryTimeout, until it is connected or the client is closed.
+func (c *Client) retryConnect() {
+	for {
+		time.Sleep(c.config.RetryTimeout)
+
+		if c.isClosed() {
+			return
+		}
+
+		err := c.connect()
+		if err == nil {
+			return
+		}
+
+		c.logger.Errorf("could not connect to AMQP broker at '%s', error: %v", c.host, err)
+	}
+}
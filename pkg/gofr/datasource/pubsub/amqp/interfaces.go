@@ -0,0 +1,38 @@
+package amqp
+
+import (
+	"context"
+
+	amqp091 "github.com/rabbitmq/amqp091-go"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=interfaces.go -destination=mock_interfaces.go -package=amqp
+
+// Connection is the connection to the AMQP broker.
+type Connection interface {
+	Channel() (Channel, error)
+	NotifyClose(receiver chan *amqp091.Error) chan *amqp091.Error
+	IsClosed() bool
+	Close() error
+}
+
+// Channel is a channel of the connection to the AMQP broker.
+type Channel interface {
+	ExchangeDeclare(name, kind string, durable, autoDelete, internal, noWait bool, args amqp091.Table) error
+	QueueDeclare(name string, durable, autoDelete, exclusive, noWait bool, args amqp091.Table) (amqp091.Queue, error)
+	QueueBind(name, key, exchange string, noWait bool, args amqp091.Table) error
+	QueueDelete(name string, ifUnused, ifEmpty, noWait bool) (int, error)
+	Qos(prefetchCount, prefetchSize int, global bool) error
+	Confirm(noWait bool) error
+	ConsumeWithContext(ctx context.Context, queue, consumer string, autoAck, exclusive, noLocal, noWait bool,
+		args amqp091.Table) (<-chan amqp091.Delivery, error)
+	PublishWithDeferredConfirmWithContext(ctx context.Context, exchange, key string, mandatory, immediate bool,
+		msg amqp091.Publishing) (Confirmation, error)
+	Get(queue string, autoAck bool) (amqp091.Delivery, bool, error)
+	Close() error
+}
+
+// Confirmation is the confirmation of a message published on a channel in confirm mode.
+type Confirmation interface {
+	WaitContext(ctx context.Context) (bool, error)
+}
@@ -0,0 +1,27 @@
+package amqp
+
+import (
+	amqp091 "github.com/rabbitmq/amqp091-go"
+
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+)
+
+// message acknowledges a delivery when it is committed, or rejects it, to be requeued or dead-lettered by the broker.
+type message struct {
+	delivery *amqp091.Delivery
+	requeue  bool
+	logger   pubsub.Logger
+}
+
+func (m *message) Commit() {
+	if err := m.delivery.Ack(false); err != nil {
+		m.logger.Errorf("failed to acknowledge AMQP message %d: %v", m.delivery.DeliveryTag, err)
+	}
+}
+
+// Reject negatively acknowledges the delivery, requeuing it when Requeue is set in the config.
+func (m *message) Reject() {
+	if err := m.delivery.Nack(false, m.requeue); err != nil {
+		m.logger.Errorf("failed to reject AMQP message %d: %v", m.delivery.DeliveryTag, err)
+	}
+}
@@ -0,0 +1,39 @@
+package amqp
+
+import (
+	"testing"
+
+	amqp091 "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+	"gofr.dev/pkg/gofr/logging"
+	"gofr.dev/pkg/gofr/testutil"
+)
+
+func TestMessage_Reject(t *testing.T) {
+	for _, requeue := range []bool{true, false} {
+		ack := &acknowledger{}
+		msg := &message{delivery: &amqp091.Delivery{Acknowledger: ack, DeliveryTag: 3}, requeue: requeue}
+
+		var rejecter pubsub.Rejecter = msg
+
+		rejecter.Reject()
+
+		assert.Equal(t, []uint64{3}, ack.nacked)
+		assert.Equal(t, requeue, ack.requeue)
+	}
+}
+
+func TestMessage_Errors(t *testing.T) {
+	out := testutil.StderrOutputForFunc(func() {
+		ack := &acknowledger{err: errBroker}
+		msg := &message{delivery: &amqp091.Delivery{Acknowledger: ack, DeliveryTag: 3}, logger: logging.NewLogger(logging.ERROR)}
+
+		msg.Commit()
+		msg.Reject()
+	})
+
+	assert.Contains(t, out, "failed to acknowledge AMQP message 3")
+	assert.Contains(t, out, "failed to reject AMQP message 3")
+}
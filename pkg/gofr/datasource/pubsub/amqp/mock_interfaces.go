@@ -0,0 +1,308 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interfaces.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interfaces.go -destination=mock_interfaces.go -package=amqp
+//
+
+// Package amqp is a generated GoMock package.
+package amqp
+
+import (
+	context "context"
+	reflect "reflect"
+
+	amqp091 "github.com/rabbitmq/amqp091-go"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockConnection is a mock of Connection interface.
+type MockConnection struct {
+	ctrl     *gomock.Controller
+	recorder *MockConnectionMockRecorder
+	isgomock struct{}
+}
+
+// MockConnectionMockRecorder is the mock recorder for MockConnection.
+type MockConnectionMockRecorder struct {
+	mock *MockConnection
+}
+
+// NewMockConnection creates a new mock instance.
+func NewMockConnection(ctrl *gomock.Controller) *MockConnection {
+	mock := &MockConnection{ctrl: ctrl}
+	mock.recorder = &MockConnectionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConnection) EXPECT() *MockConnectionMockRecorder {
+	return m.recorder
+}
+
+// Channel mocks base method.
+func (m *MockConnection) Channel() (Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Channel")
+	ret0, _ := ret[0].(Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Channel indicates an expected call of Channel.
+func (mr *MockConnectionMockRecorder) Channel() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Channel", reflect.TypeOf((*MockConnection)(nil).Channel))
+}
+
+// Close mocks base method.
+func (m *MockConnection) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockConnectionMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockConnection)(nil).Close))
+}
+
+// IsClosed mocks base method.
+func (m *MockConnection) IsClosed() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsClosed")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsClosed indicates an expected call of IsClosed.
+func (mr *MockConnectionMockRecorder) IsClosed() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsClosed", reflect.TypeOf((*MockConnection)(nil).IsClosed))
+}
+
+// NotifyClose mocks base method.
+func (m *MockConnection) NotifyClose(receiver chan *amqp091.Error) chan *amqp091.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyClose", receiver)
+	ret0, _ := ret[0].(chan *amqp091.Error)
+	return ret0
+}
+
+// NotifyClose indicates an expected call of NotifyClose.
+func (mr *MockConnectionMockRecorder) NotifyClose(receiver any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyClose", reflect.TypeOf((*MockConnection)(nil).NotifyClose), receiver)
+}
+
+// MockChannel is a mock of Channel interface.
+type MockChannel struct {
+	ctrl     *gomock.Controller
+	recorder *MockChannelMockRecorder
+	isgomock struct{}
+}
+
+// MockChannelMockRecorder is the mock recorder for MockChannel.
+type MockChannelMockRecorder struct {
+	mock *MockChannel
+}
+
+// NewMockChannel creates a new mock instance.
+func NewMockChannel(ctrl *gomock.Controller) *MockChannel {
+	mock := &MockChannel{ctrl: ctrl}
+	mock.recorder = &MockChannelMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChannel) EXPECT() *MockChannelMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockChannel) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockChannelMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockChannel)(nil).Close))
+}
+
+// Confirm mocks base method.
+func (m *MockChannel) Confirm(noWait bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Confirm", noWait)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Confirm indicates an expected call of Confirm.
+func (mr *MockChannelMockRecorder) Confirm(noWait any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MockChannel)(nil).Confirm), noWait)
+}
+
+// ConsumeWithContext mocks base method.
+func (m *MockChannel) ConsumeWithContext(ctx context.Context, queue, consumer string, autoAck, exclusive, noLocal, noWait bool, args amqp091.Table) (<-chan amqp091.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeWithContext", ctx, queue, consumer, autoAck, exclusive, noLocal, noWait, args)
+	ret0, _ := ret[0].(<-chan amqp091.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeWithContext indicates an expected call of ConsumeWithContext.
+func (mr *MockChannelMockRecorder) ConsumeWithContext(ctx, queue, consumer, autoAck, exclusive, noLocal, noWait, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeWithContext", reflect.TypeOf((*MockChannel)(nil).ConsumeWithContext), ctx, queue, consumer, autoAck, exclusive, noLocal, noWait, args)
+}
+
+// ExchangeDeclare mocks base method.
+func (m *MockChannel) ExchangeDeclare(name, kind string, durable, autoDelete, internal, noWait bool, args amqp091.Table) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExchangeDeclare", name, kind, durable, autoDelete, internal, noWait, args)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExchangeDeclare indicates an expected call of ExchangeDeclare.
+func (mr *MockChannelMockRecorder) ExchangeDeclare(name, kind, durable, autoDelete, internal, noWait, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExchangeDeclare", reflect.TypeOf((*MockChannel)(nil).ExchangeDeclare), name, kind, durable, autoDelete, internal, noWait, args)
+}
+
+// Get mocks base method.
+func (m *MockChannel) Get(queue string, autoAck bool) (amqp091.Delivery, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", queue, autoAck)
+	ret0, _ := ret[0].(amqp091.Delivery)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockChannelMockRecorder) Get(queue, autoAck any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockChannel)(nil).Get), queue, autoAck)
+}
+
+// PublishWithDeferredConfirmWithContext mocks base method.
+func (m *MockChannel) PublishWithDeferredConfirmWithContext(ctx context.Context, exchange, key string, mandatory, immediate bool, msg amqp091.Publishing) (Confirmation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishWithDeferredConfirmWithContext", ctx, exchange, key, mandatory, immediate, msg)
+	ret0, _ := ret[0].(Confirmation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishWithDeferredConfirmWithContext indicates an expected call of PublishWithDeferredConfirmWithContext.
+func (mr *MockChannelMockRecorder) PublishWithDeferredConfirmWithContext(ctx, exchange, key, mandatory, immediate, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishWithDeferredConfirmWithContext", reflect.TypeOf((*MockChannel)(nil).PublishWithDeferredConfirmWithContext), ctx, exchange, key, mandatory, immediate, msg)
+}
+
+// Qos mocks base method.
+func (m *MockChannel) Qos(prefetchCount, prefetchSize int, global bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Qos", prefetchCount, prefetchSize, global)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Qos indicates an expected call of Qos.
+func (mr *MockChannelMockRecorder) Qos(prefetchCount, prefetchSize, global any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Qos", reflect.TypeOf((*MockChannel)(nil).Qos), prefetchCount, prefetchSize, global)
+}
+
+// QueueBind mocks base method.
+func (m *MockChannel) QueueBind(name, key, exchange string, noWait bool, args amqp091.Table) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueBind", name, key, exchange, noWait, args)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// QueueBind indicates an expected call of QueueBind.
+func (mr *MockChannelMockRecorder) QueueBind(name, key, exchange, noWait, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueBind", reflect.TypeOf((*MockChannel)(nil).QueueBind), name, key, exchange, noWait, args)
+}
+
+// QueueDeclare mocks base method.
+func (m *MockChannel) QueueDeclare(name string, durable, autoDelete, exclusive, noWait bool, args amqp091.Table) (amqp091.Queue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueDeclare", name, durable, autoDelete, exclusive, noWait, args)
+	ret0, _ := ret[0].(amqp091.Queue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueueDeclare indicates an expected call of QueueDeclare.
+func (mr *MockChannelMockRecorder) QueueDeclare(name, durable, autoDelete, exclusive, noWait, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueDeclare", reflect.TypeOf((*MockChannel)(nil).QueueDeclare), name, durable, autoDelete, exclusive, noWait, args)
+}
+
+// QueueDelete mocks base method.
+func (m *MockChannel) QueueDelete(name string, ifUnused, ifEmpty, noWait bool) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueDelete", name, ifUnused, ifEmpty, noWait)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueueDelete indicates an expected call of QueueDelete.
+func (mr *MockChannelMockRecorder) QueueDelete(name, ifUnused, ifEmpty, noWait any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueDelete", reflect.TypeOf((*MockChannel)(nil).QueueDelete), name, ifUnused, ifEmpty, noWait)
+}
+
+// MockConfirmation is a mock of Confirmation interface.
+type MockConfirmation struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfirmationMockRecorder
+	isgomock struct{}
+}
+
+// MockConfirmationMockRecorder is the mock recorder for MockConfirmation.
+type MockConfirmationMockRecorder struct {
+	mock *MockConfirmation
+}
+
+// NewMockConfirmation creates a new mock instance.
+func NewMockConfirmation(ctrl *gomock.Controller) *MockConfirmation {
+	mock := &MockConfirmation{ctrl: ctrl}
+	mock.recorder = &MockConfirmationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfirmation) EXPECT() *MockConfirmationMockRecorder {
+	return m.recorder
+}
+
+// WaitContext mocks base method.
+func (m *MockConfirmation) WaitContext(ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitContext", ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitContext indicates an expected call of WaitContext.
+func (mr *MockConfirmationMockRecorder) WaitContext(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitContext", reflect.TypeOf((*MockConfirmation)(nil).WaitContext), ctx)
+}
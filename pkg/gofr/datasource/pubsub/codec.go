@@ -0,0 +1,32 @@
+package pubsub
+
+import (
+	"context"
+)
+
+// Codec encodes the values published to a topic into message values, and decodes the message values received from
+// a topic, e.g. as per a schema in a schema registry. It is set on the subscriptions with gofr.WithCodec,
+// so that Message.Bind decodes the messages with it.
+type Codec interface {
+	// ContentType returns the content type of the encoded messages, sent in their content-type header.
+	ContentType() string
+	// Encode returns the message value of v, published to the topic.
+	Encode(ctx context.Context, topic string, v any) ([]byte, error)
+	// Decode decodes the message value received from the topic into v, which should be a pointer.
+	Decode(ctx context.Context, topic string, data []byte, v any) error
+}
+
+// PublishEncoded encodes v with the codec and publishes it to the topic, with the content type of the codec
+// when the options do not set one.
+func PublishEncoded(ctx context.Context, publisher Publisher, codec Codec, topic string, v any, options PublishOptions) error {
+	value, err := codec.Encode(ctx, topic, v)
+	if err != nil {
+		return err
+	}
+
+	if options.ContentType == "" {
+		options.ContentType = codec.ContentType()
+	}
+
+	return PublishWithOptions(ctx, publisher, topic, value, options)
+}
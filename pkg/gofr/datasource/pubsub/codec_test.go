@@ -0,0 +1,111 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errEncode = errors.New("encode error")
+
+// upperCodec encodes the strings in upper case, and decodes them in lower case.
+type upperCodec struct{}
+
+func (upperCodec) ContentType() string { return "text/upper" }
+
+func (upperCodec) Encode(_ context.Context, _ string, v any) ([]byte, error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, errEncode
+	}
+
+	return []byte(strings.ToUpper(s)), nil
+}
+
+func (upperCodec) Decode(_ context.Context, topic string, data []byte, v any) error {
+	*v.(*string) = topic + ":" + strings.ToLower(string(data))
+
+	return nil
+}
+
+type publisher struct {
+	topic   string
+	value   []byte
+	options PublishOptions
+}
+
+func (p *publisher) Publish(ctx context.Context, topic string, message []byte) error {
+	return p.PublishWithOptions(ctx, topic, message, PublishOptions{})
+}
+
+func (p *publisher) PublishWithOptions(_ context.Context, topic string, message []byte, options PublishOptions) error {
+	p.topic, p.value, p.options = topic, message, options
+
+	return nil
+}
+
+func TestMessage_BindWithCodec(t *testing.T) {
+	m := NewMessage(t.Context())
+	m.Topic = "orders"
+	m.Value = []byte("HELLO")
+
+	m.SetCodec(upperCodec{})
+
+	var s string
+
+	require.NoError(t, m.Bind(&s))
+	assert.Equal(t, "orders:hello", s)
+
+	require.ErrorIs(t, m.Bind(s), errNotPointer)
+}
+
+func TestPublishEncoded(t *testing.T) {
+	p := &publisher{}
+
+	err := PublishEncoded(t.Context(), p, upperCodec{}, "orders", "hello", PublishOptions{Key: "order-1"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "orders", p.topic)
+	assert.Equal(t, []byte("HELLO"), p.value)
+	assert.Equal(t, PublishOptions{Key: "order-1", ContentType: "text/upper"}, p.options)
+
+	err = PublishEncoded(t.Context(), p, upperCodec{}, "orders", "hello", PublishOptions{ContentType: "text/plain"})
+	require.NoError(t, err)
+	assert.Equal(t, "text/plain", p.options.ContentType, "content type of the options is overridden")
+
+	err = PublishEncoded(t.Context(), p, upperCodec{}, "orders", 1, PublishOptions{})
+	require.ErrorIs(t, err, errEncode)
+}
+
+// plainPublisher is the Publisher which does not publish the messages with options.
+type plainPublisher struct {
+	topic string
+	value []byte
+}
+
+func (p *plainPublisher) Publish(_ context.Context, topic string, message []byte) error {
+	p.topic, p.value = topic, message
+
+	return nil
+}
+
+func TestPublishWithOptions(t *testing.T) {
+	options := PublishOptions{Headers: map[string]string{"tenant": "gofr"}, Key: "order-1"}
+
+	p := &publisher{}
+
+	require.NoError(t, PublishWithOptions(t.Context(), p, "orders", []byte("1"), options))
+	assert.Equal(t, "orders", p.topic)
+	assert.Equal(t, []byte("1"), p.value)
+	assert.Equal(t, options, p.options)
+
+	plain := &plainPublisher{}
+
+	require.NoError(t, PublishWithOptions(t.Context(), plain, "orders", []byte("1"), options))
+	assert.Equal(t, "orders", plain.topic, "the message is published without the options")
+	assert.Equal(t, []byte("1"), plain.value)
+}
@@ -299,6 +299,7 @@ func (c *Client) processEventsFromPartitionClient(ctx context.Context, topic str
 	}
 	msg.Topic = topic
 	msg.MetaData = events[0].EventData
+	setMessageHeaders(msg, events[0])
 
 	end := time.Since(start)
 	c.logger.Debug(&Log{
@@ -384,6 +385,7 @@ func (c *Client) tryReadFromPartition(ctx context.Context, partitionID, topic st
 	}
 	msg.Topic = topic
 	msg.MetaData = events[0].EventData
+	setMessageHeaders(msg, events[0])
 
 	end := time.Since(start)
 	c.logger.Debug(&Log{
@@ -433,6 +435,13 @@ func closePartitionResources(ctx context.Context, partitionClient *azeventhubs.P
 }
 
 func (c *Client) Publish(ctx context.Context, topic string, message []byte) error {
+	return c.PublishWithOptions(ctx, topic, message, pubsub.PublishOptions{})
+}
+
+// PublishWithOptions publishes the message with the headers of the options and the trace context as the properties
+// of the event, the content type as its content type and the key as its partition key, so that the events with
+// the same key are sent to the same partition.
+func (c *Client) PublishWithOptions(ctx context.Context, topic string, message []byte, options pubsub.PublishOptions) error {
 	if topic != c.cfg.EventhubName {
 		return ErrTopicMismatch
 	}
@@ -441,6 +450,10 @@ func (c *Client) Publish(ctx context.Context, topic string, message []byte) erro
 
 	newBatchOptions := &azeventhubs.EventDataBatchOptions{}
 
+	if options.Key != "" {
+		newBatchOptions.PartitionKey = &options.Key
+	}
+
 	batch, err := c.producer.NewEventDataBatch(ctx, newBatchOptions)
 	if err != nil {
 		c.logger.Errorf("failed to create event batch %v", err)
@@ -448,9 +461,9 @@ func (c *Client) Publish(ctx context.Context, topic string, message []byte) erro
 		return err
 	}
 
-	data := []*azeventhubs.EventData{{
-		Body: message,
-	}}
+	options.Headers = pubsub.InjectTraceContext(ctx, options.Headers)
+
+	data := []*azeventhubs.EventData{newEventData(message, options)}
 
 	for i := 0; i < len(data); i++ {
 		err = batch.AddEventData(data[i], nil)
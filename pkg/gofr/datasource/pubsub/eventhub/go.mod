@@ -10,7 +10,7 @@ require (
 	go.opentelemetry.io/otel v1.38.0
 	go.opentelemetry.io/otel/trace v1.38.0
 	go.uber.org/mock v0.6.0
-	gofr.dev v1.47.0
+	gofr.dev v1.47.0 // bump to the first release with pubsub.PublishOptions before tagging
 	nhooyr.io/websocket v1.8.11
 )
 
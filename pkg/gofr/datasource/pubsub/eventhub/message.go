@@ -2,8 +2,10 @@ package eventhub
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azeventhubs"
+	"gofr.dev/pkg/gofr/datasource/pubsub"
 )
 
 type Message struct {
@@ -26,3 +28,47 @@ func (a *Message) Commit() {
 		a.logger.Debugf("Message acknowledged (direct read mode)")
 	}
 }
+
+// newEventData returns the event with the message as its body, the headers of the options as its properties
+// and the content type of the options as its content type.
+func newEventData(message []byte, options pubsub.PublishOptions) *azeventhubs.EventData {
+	event := &azeventhubs.EventData{Body: message}
+
+	if options.ContentType != "" {
+		event.ContentType = &options.ContentType
+	}
+
+	if len(options.Headers) > 0 {
+		event.Properties = make(map[string]any, len(options.Headers))
+
+		for k, v := range options.Headers {
+			event.Properties[k] = v
+		}
+	}
+
+	return event
+}
+
+// setMessageHeaders sets the properties and the content type of the event as the headers of the message,
+// and its partition key as the key of the message.
+func setMessageHeaders(msg *pubsub.Message, event *azeventhubs.ReceivedEventData) {
+	if event.PartitionKey != nil {
+		msg.Key = *event.PartitionKey
+	}
+
+	if len(event.Properties) == 0 && event.ContentType == nil {
+		return
+	}
+
+	headers := make(map[string]string, len(event.Properties)+1)
+
+	for k, v := range event.Properties {
+		headers[k] = fmt.Sprint(v)
+	}
+
+	if event.ContentType != nil {
+		headers[pubsub.ContentTypeHeader] = *event.ContentType
+	}
+
+	msg.SetHeaders(headers)
+}
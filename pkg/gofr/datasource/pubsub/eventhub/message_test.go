@@ -0,0 +1,52 @@
+package eventhub
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azeventhubs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+)
+
+func TestNewEventData(t *testing.T) {
+	event := newEventData([]byte("hello"), pubsub.PublishOptions{
+		Headers:     map[string]string{"tenant": "gofr"},
+		Key:         "order-1",
+		ContentType: "application/json",
+	})
+
+	assert.Equal(t, []byte("hello"), event.Body)
+	assert.Equal(t, map[string]any{"tenant": "gofr"}, event.Properties)
+	require.NotNil(t, event.ContentType)
+	assert.Equal(t, "application/json", *event.ContentType)
+
+	event = newEventData([]byte("hello"), pubsub.PublishOptions{})
+
+	assert.Nil(t, event.Properties)
+	assert.Nil(t, event.ContentType)
+}
+
+func TestSetMessageHeaders(t *testing.T) {
+	contentType, key := "application/json", "order-1"
+
+	msg := pubsub.NewMessage(t.Context())
+
+	setMessageHeaders(msg, &azeventhubs.ReceivedEventData{
+		EventData: azeventhubs.EventData{
+			ContentType: &contentType,
+			Properties:  map[string]any{"tenant": "gofr", "retries": 2},
+		},
+		PartitionKey: &key,
+	})
+
+	assert.Equal(t, map[string]string{"tenant": "gofr", "retries": "2", "content-type": "application/json"}, msg.Headers())
+	assert.Equal(t, "order-1", msg.Key)
+
+	msg = pubsub.NewMessage(t.Context())
+
+	setMessageHeaders(msg, &azeventhubs.ReceivedEventData{})
+
+	assert.Nil(t, msg.Headers())
+	assert.Empty(t, msg.Key)
+}
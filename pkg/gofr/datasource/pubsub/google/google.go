@@ -112,6 +112,12 @@ func connect(conf Config, logger pubsub.Logger) (*gcPubSub.Client, error) {
 }
 
 func (g *googleClient) Publish(ctx context.Context, topic string, message []byte) error {
+	return g.PublishWithOptions(ctx, topic, message, pubsub.PublishOptions{})
+}
+
+// PublishWithOptions publishes the message with the headers of the options and the trace context as its attributes,
+// and the key as its ordering key, so that the messages with the same key are delivered in order.
+func (g *googleClient) PublishWithOptions(ctx context.Context, topic string, message []byte, options pubsub.PublishOptions) error {
 	ctx, span := otel.GetTracerProvider().Tracer("gofr").Start(ctx, "publish-gcp")
 	defer span.End()
 
@@ -124,9 +130,13 @@ func (g *googleClient) Publish(ctx context.Context, topic string, message []byte
 		return err
 	}
 
+	t.EnableMessageOrdering = options.Key != ""
+
 	start := time.Now()
 	result := t.Publish(ctx, &gcPubSub.Message{
 		Data:        message,
+		Attributes:  pubsub.InjectTraceContext(ctx, options.AllHeaders()),
+		OrderingKey: options.Key,
 		PublishTime: time.Now(),
 	})
 	end := time.Since(start)
@@ -190,8 +200,14 @@ func (g *googleClient) Subscribe(ctx context.Context, topic string) (*pubsub.Mes
 
 			m.Topic = topic
 			m.Value = msg.Data
+			m.Key = msg.OrderingKey
 			m.MetaData = msg.Attributes
-			m.Committer = newGoogleMessage(msg)
+			m.SetHeaders(msg.Attributes)
+			m.Committer = newGoogleMessage(msg, topic, g.logger, g.metrics)
+
+			// the client does not report the backlog of the subscription, so the lag is the age of the message.
+			g.metrics.SetGauge("app_pubsub_consumer_lag_seconds", time.Since(msg.PublishTime).Seconds(), "topic", topic,
+				"subscription_name", g.Config.SubscriptionName)
 
 			g.mu.Lock()
 			defer g.mu.Unlock()
@@ -327,7 +343,8 @@ func (g *googleClient) getSubscription(ctx context.Context, topic *gcPubSub.Topi
 	// if subscription is not present, create a new
 	if !ok {
 		subscription, err = g.client.CreateSubscription(ctx, g.SubscriptionName+"-"+topic.ID(), gcPubSub.SubscriptionConfig{
-			Topic: topic,
+			Topic:                 topic,
+			EnableMessageOrdering: true,
 		})
 		if err != nil {
 			return nil, err
@@ -126,6 +126,39 @@ func TestGoogleClient_Publish_Success(t *testing.T) {
 	assert.Contains(t, out, "GCP")
 }
 
+func TestGoogleClient_PublishWithOptions(t *testing.T) {
+	client := getGoogleClient(t)
+
+	defer client.Close()
+
+	ctrl := gomock.NewController(t)
+
+	defer ctrl.Finish()
+
+	mockMetrics := NewMockMetrics(ctrl)
+
+	g := &googleClient{
+		logger:  logging.NewMockLogger(logging.INFO),
+		client:  client,
+		Config:  Config{ProjectID: "test", SubscriptionName: "sub"},
+		metrics: mockMetrics,
+	}
+
+	mockMetrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_publish_total_count", "topic", "test-topic").Times(2)
+	mockMetrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_publish_success_count", "topic", "test-topic").Times(2)
+
+	// the messages with and without an ordering key are published to the same topic.
+	err := g.PublishWithOptions(t.Context(), "test-topic", []byte(`{"id":1}`), pubsub.PublishOptions{
+		Headers:     map[string]string{"tenant": "gofr"},
+		Key:         "order-1",
+		ContentType: "application/json",
+	})
+	require.NoError(t, err)
+
+	err = g.Publish(t.Context(), "test-topic", []byte(`{"id":2}`))
+	require.NoError(t, err)
+}
+
 func TestGoogleClient_PublishTopic_Error(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
@@ -647,6 +680,8 @@ func TestGoogleClient_Subscribe_Success(t *testing.T) {
 		"topic", topic, "subscription_name", g.Config.SubscriptionName).AnyTimes()
 	mockMetrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_subscribe_success_count",
 		"topic", topic, "subscription_name", g.Config.SubscriptionName).AnyTimes()
+	mockMetrics.EXPECT().SetGauge("app_pubsub_consumer_lag_seconds", gomock.Any(), "topic", topic,
+		"subscription_name", g.Config.SubscriptionName).MinTimes(1)
 
 	// Create topic and publish a message
 	topicObj, err := client.CreateTopic(t.Context(), topic)
@@ -671,7 +706,7 @@ func TestGoogleClient_Subscribe_Success(t *testing.T) {
 	time.Sleep(500 * time.Millisecond)
 
 	// Publish another message
-	result = topicObj.Publish(t.Context(), &gcPubSub.Message{Data: message})
+	result = topicObj.Publish(t.Context(), &gcPubSub.Message{Data: message, Attributes: map[string]string{"tenant": "gofr"}})
 	_, err = result.Get(t.Context())
 	require.NoError(t, err)
 
@@ -682,6 +717,7 @@ func TestGoogleClient_Subscribe_Success(t *testing.T) {
 	require.NotNil(t, msg)
 	assert.Equal(t, message, msg.Value)
 	assert.Equal(t, topic, msg.Topic)
+	assert.Equal(t, "gofr", msg.Header("tenant"))
 }
 
 func TestGoogleClient_Subscribe_ContextCanceled(t *testing.T) {
@@ -1,17 +1,32 @@
 package google
 
 import (
+	"context"
+
 	gcPubSub "cloud.google.com/go/pubsub"
+
+	"gofr.dev/pkg/gofr/datasource/pubsub"
 )
 
 type googleMessage struct {
-	msg *gcPubSub.Message
+	msg     *gcPubSub.Message
+	topic   string
+	logger  pubsub.Logger
+	metrics Metrics
 }
 
-func newGoogleMessage(msg *gcPubSub.Message) *googleMessage {
-	return &googleMessage{msg: msg}
+func newGoogleMessage(msg *gcPubSub.Message, topic string, logger pubsub.Logger, metrics Metrics) *googleMessage {
+	return &googleMessage{msg: msg, topic: topic, logger: logger, metrics: metrics}
 }
 
+// Commit acknowledges the message. The failure of the acknowledgement is reported only for the subscriptions with
+// exactly-once delivery, for which it waits for the acknowledgement to be confirmed.
 func (gm *googleMessage) Commit() {
-	gm.msg.Ack()
+	ctx := context.Background()
+
+	_, err := gm.msg.AckWithResult().Get(ctx)
+	if err != nil {
+		gm.logger.Errorf("unable to acknowledge message on google: %v", err)
+		gm.metrics.IncrementCounter(ctx, "app_pubsub_commit_failure_count", "topic", gm.topic)
+	}
 }
@@ -10,13 +10,15 @@ import (
 func TestNew(t *testing.T) {
 	msg := new(gcPubSub.Message)
 
-	out := newGoogleMessage(msg)
+	out := newGoogleMessage(msg, "test", nil, nil)
 
 	assert.Equal(t, msg, out.msg)
+	assert.Equal(t, "test", out.topic)
 }
 
 func TestGoogleMessage_Commit(_ *testing.T) {
-	msg := newGoogleMessage(&gcPubSub.Message{})
+	// the acknowledgement of a message not received from a subscription succeeds.
+	msg := newGoogleMessage(&gcPubSub.Message{}, "test", nil, nil)
 
 	msg.Commit()
 }
@@ -12,6 +12,58 @@ type Publisher interface {
 	Publish(ctx context.Context, topic string, message []byte) error
 }
 
+// OptionsPublisher is the Publisher which publishes the messages with headers, a key and a content type.
+// It is implemented by the pub/sub clients of GoFr; PublishWithOptions falls back to Publish for the clients
+// which do not implement it.
+type OptionsPublisher interface {
+	Publisher
+	// PublishWithOptions publishes the message with the headers, key and content type set in the options.
+	PublishWithOptions(ctx context.Context, topic string, message []byte, options PublishOptions) error
+}
+
+// PublishWithOptions publishes the message with the options when the publisher is an OptionsPublisher, and
+// without them otherwise, so that the headers, key and content type are dropped by the publishers which do not
+// support them.
+func PublishWithOptions(ctx context.Context, publisher Publisher, topic string, message []byte, options PublishOptions) error {
+	if p, ok := publisher.(OptionsPublisher); ok {
+		return p.PublishWithOptions(ctx, topic, message, options)
+	}
+
+	return publisher.Publish(ctx, topic, message)
+}
+
+// PublishOptions are the headers, key and content type of a published message.
+type PublishOptions struct {
+	// Headers are sent as the headers of the message, or its attributes or properties as per the broker.
+	Headers map[string]string
+	// Key is the partition key in Kafka and Event Hub, and the ordering key in Google PubSub, so that the messages
+	// with the same key are delivered in order.
+	Key string
+	// ContentType is sent in the content-type header, e.g. application/json.
+	ContentType string
+}
+
+// IsEmpty reports whether no headers, key or content type are set in the options.
+func (o *PublishOptions) IsEmpty() bool {
+	return len(o.Headers) == 0 && o.Key == "" && o.ContentType == ""
+}
+
+// AllHeaders returns the headers of the options, including the content-type header when ContentType is set.
+func (o *PublishOptions) AllHeaders() map[string]string {
+	if o.ContentType == "" {
+		return o.Headers
+	}
+
+	headers := make(map[string]string, len(o.Headers)+1)
+	for k, v := range o.Headers {
+		headers[k] = v
+	}
+
+	headers[ContentTypeHeader] = o.ContentType
+
+	return headers
+}
+
 type Subscriber interface {
 	Subscribe(ctx context.Context, topic string) (*Message, error)
 }
@@ -32,6 +84,22 @@ type Committer interface {
 	Commit()
 }
 
+// PartitionCommitter is the Committer of the messages of the brokers, e.g. Kafka, in which committing a message
+// commits the earlier messages of its partition as well. When the messages are handled concurrently, they are
+// committed in the order they are read from the partition, so that no message is committed before it is handled.
+type PartitionCommitter interface {
+	Committer
+	Partition() int
+}
+
+// Rejecter is the Committer of the messages of the brokers, e.g. RabbitMQ, which hold a delivered message until it is
+// acknowledged. The messages which are not committed by the subscriber are rejected, for the broker to redeliver or
+// dead-letter them.
+type Rejecter interface {
+	Committer
+	Reject()
+}
+
 type Logger interface {
 	Debugf(format string, args ...any)
 	Debug(args ...any)
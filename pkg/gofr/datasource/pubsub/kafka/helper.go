@@ -144,6 +144,7 @@ func createKafkaWriter(conf *Config, dialer *kafka.Dialer, logger pubsub.Logger)
 	return kafka.NewWriter(kafka.WriterConfig{
 		Brokers:      conf.Brokers,
 		Dialer:       dialer,
+		Balancer:     &kafka.Hash{},
 		BatchSize:    conf.BatchSize,
 		BatchBytes:   conf.BatchBytes,
 		BatchTimeout: time.Duration(conf.BatchTimeout),
@@ -226,3 +227,41 @@ func (k *kafkaClient) readMessages(ctx context.Context, reader *kafka.Reader, li
 func (*kafkaClient) isExpectedError(err error) bool {
 	return errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.EOF)
 }
+
+// messageKey returns the key of the Kafka message, nil for an empty key so that the message is balanced
+// across the partitions in round-robin.
+func messageKey(key string) []byte {
+	if key == "" {
+		return nil
+	}
+
+	return []byte(key)
+}
+
+func messageHeaders(headers map[string]string) []kafka.Header {
+	if len(headers) == 0 {
+		return nil
+	}
+
+	kafkaHeaders := make([]kafka.Header, 0, len(headers))
+
+	for k, v := range headers {
+		kafkaHeaders = append(kafkaHeaders, kafka.Header{Key: k, Value: []byte(v)})
+	}
+
+	return kafkaHeaders
+}
+
+func headersFromMessage(kafkaHeaders []kafka.Header) map[string]string {
+	if len(kafkaHeaders) == 0 {
+		return nil
+	}
+
+	headers := make(map[string]string, len(kafkaHeaders))
+
+	for _, h := range kafkaHeaders {
+		headers[h.Key] = string(h.Value)
+	}
+
+	return headers
+}
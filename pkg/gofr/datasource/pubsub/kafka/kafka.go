@@ -5,6 +5,7 @@ package kafka
 import (
 	"context"
 	"errors"
+	"strconv"
 	"sync"
 	"time"
 
@@ -98,6 +99,12 @@ func New(conf *Config, logger pubsub.Logger, metrics Metrics) *kafkaClient { //n
 }
 
 func (k *kafkaClient) Publish(ctx context.Context, topic string, message []byte) error {
+	return k.PublishWithOptions(ctx, topic, message, pubsub.PublishOptions{})
+}
+
+// PublishWithOptions publishes the message with the headers of the options and the trace context as the Kafka headers,
+// and the key as the message key, so that the messages with the same key are written to the same partition.
+func (k *kafkaClient) PublishWithOptions(ctx context.Context, topic string, message []byte, options pubsub.PublishOptions) error {
 	ctx, span := otel.GetTracerProvider().Tracer("gofr").Start(ctx, "kafka-publish")
 	defer span.End()
 
@@ -110,9 +117,11 @@ func (k *kafkaClient) Publish(ctx context.Context, topic string, message []byte)
 	start := time.Now()
 	err := k.writer.WriteMessages(ctx,
 		kafka.Message{
-			Topic: topic,
-			Value: message,
-			Time:  time.Now(),
+			Topic:   topic,
+			Key:     messageKey(options.Key),
+			Value:   message,
+			Headers: messageHeaders(pubsub.InjectTraceContext(ctx, options.AllHeaders())),
+			Time:    time.Now(),
 		},
 	)
 	end := time.Since(start)
@@ -215,7 +224,11 @@ func (k *kafkaClient) Subscribe(ctx context.Context, topic string) (*pubsub.Mess
 	m := pubsub.NewMessage(ctx)
 	m.Value = msg.Value
 	m.Topic = topic
-	m.Committer = newKafkaMessage(&msg, k.reader[topic], k.logger)
+	m.Key = string(msg.Key)
+	m.SetHeaders(headersFromMessage(msg.Headers))
+	m.Committer = newKafkaMessage(&msg, k.reader[topic], k.logger, k.metrics)
+
+	k.setConsumerLag(&msg)
 
 	end := time.Since(start)
 
@@ -242,6 +255,15 @@ func (k *kafkaClient) Subscribe(ctx context.Context, topic string) (*pubsub.Mess
 	return m, err
 }
 
+// setConsumerLag sets the lag of the consumer group on the partition of the message, the number of the messages
+// of the partition after the message.
+func (k *kafkaClient) setConsumerLag(msg *kafka.Message) {
+	lag := max(msg.HighWaterMark-msg.Offset-1, 0)
+
+	k.metrics.SetGauge("app_pubsub_consumer_lag", float64(lag), "topic", msg.Topic, "partition", strconv.Itoa(msg.Partition),
+		"consumer_group", k.config.ConsumerGroupID)
+}
+
 func (k *kafkaClient) Close() (err error) {
 	for _, r := range k.reader {
 		err = errors.Join(err, r.Close())
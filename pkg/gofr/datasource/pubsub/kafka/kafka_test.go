@@ -287,6 +287,42 @@ func TestKafkaClient_Publish(t *testing.T) {
 	assert.Contains(t, logs, "test")
 }
 
+func TestKafkaClient_PublishWithOptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockWriter := NewMockWriter(ctrl)
+	mockMetrics := NewMockMetrics(ctrl)
+
+	k := &kafkaClient{
+		writer:  mockWriter,
+		logger:  logging.NewMockLogger(logging.INFO),
+		metrics: mockMetrics,
+		config:  Config{Brokers: []string{"localhost:9092"}},
+	}
+
+	mockWriter.EXPECT().WriteMessages(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, msgs ...kafka.Message) error {
+		require.Len(t, msgs, 1)
+		assert.Equal(t, []byte("order-1"), msgs[0].Key)
+		assert.ElementsMatch(t, []kafka.Header{
+			{Key: "tenant", Value: []byte("gofr")},
+			{Key: "content-type", Value: []byte("application/json")},
+		}, msgs[0].Headers)
+
+		return nil
+	})
+	mockMetrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_publish_total_count", "topic", "test")
+	mockMetrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_publish_success_count", "topic", "test")
+
+	err := k.PublishWithOptions(t.Context(), "test", []byte(`{"id":1}`), pubsub.PublishOptions{
+		Headers:     map[string]string{"tenant": "gofr"},
+		Key:         "order-1",
+		ContentType: "application/json",
+	})
+
+	require.NoError(t, err)
+}
+
 func TestKafkaClient_SubscribeSuccess(t *testing.T) {
 	var (
 		msg *pubsub.Message
@@ -329,11 +365,14 @@ func TestKafkaClient_SubscribeSuccess(t *testing.T) {
 
 	mockConnection.EXPECT().Controller().Return(kafka.Broker{}, nil)
 	mockReader.EXPECT().FetchMessage(gomock.Any()).
-		Return(kafka.Message{Value: []byte(`hello`), Topic: "test"}, nil)
+		Return(kafka.Message{Value: []byte(`hello`), Topic: "test", Key: []byte("order-1"), Partition: 2, Offset: 5,
+			HighWaterMark: 9, Headers: []kafka.Header{{Key: "content-type", Value: []byte("application/json")}}}, nil)
 	mockMetrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_subscribe_total_count", "topic", "test",
 		"consumer_group", gomock.Any())
 	mockMetrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_subscribe_success_count", "topic", "test",
 		"consumer_group", gomock.Any())
+	mockMetrics.EXPECT().SetGauge("app_pubsub_consumer_lag", 3.0, "topic", "test", "partition", "2",
+		"consumer_group", "consumer")
 
 	logs := testutil.StdoutOutputForFunc(func() {
 		logger := logging.NewMockLogger(logging.DEBUG)
@@ -346,6 +385,8 @@ func TestKafkaClient_SubscribeSuccess(t *testing.T) {
 	assert.NotNil(t, msg.Context())
 	assert.Equal(t, expMessage.Value, msg.Value)
 	assert.Equal(t, expMessage.Topic, msg.Topic)
+	assert.Equal(t, "order-1", msg.Key)
+	assert.Equal(t, "application/json", msg.ContentType())
 	assert.Contains(t, logs, "KAFKA")
 	assert.Contains(t, logs, "hello")
 	assert.Contains(t, logs, "kafkabroker")
@@ -9,24 +9,34 @@ import (
 )
 
 type kafkaMessage struct {
-	msg    *kafka.Message
-	reader Reader
-	logger pubsub.Logger
+	msg     *kafka.Message
+	reader  Reader
+	logger  pubsub.Logger
+	metrics Metrics
 }
 
-func newKafkaMessage(msg *kafka.Message, reader Reader, logger pubsub.Logger) *kafkaMessage {
+func newKafkaMessage(msg *kafka.Message, reader Reader, logger pubsub.Logger, metrics Metrics) *kafkaMessage {
 	return &kafkaMessage{
-		msg:    msg,
-		reader: reader,
-		logger: logger,
+		msg:     msg,
+		reader:  reader,
+		logger:  logger,
+		metrics: metrics,
 	}
 }
 
+// Partition returns the partition of the message, as committing the message commits its offset in the partition.
+func (kmsg *kafkaMessage) Partition() int {
+	return kmsg.msg.Partition
+}
+
 func (kmsg *kafkaMessage) Commit() {
 	if kmsg.reader != nil {
-		err := kmsg.reader.CommitMessages(context.Background(), *kmsg.msg)
+		ctx := context.Background()
+
+		err := kmsg.reader.CommitMessages(ctx, *kmsg.msg)
 		if err != nil {
 			kmsg.logger.Errorf("unable to commit message on kafka")
+			kmsg.metrics.IncrementCounter(ctx, "app_pubsub_commit_failure_count", "topic", kmsg.msg.Topic)
 		}
 	}
 }
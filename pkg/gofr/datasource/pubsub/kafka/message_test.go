@@ -14,13 +14,19 @@ import (
 func TestNewMessage(t *testing.T) {
 	msg := new(kafka.Message)
 	reader := new(kafka.Reader)
-	k := newKafkaMessage(msg, reader, nil)
+	k := newKafkaMessage(msg, reader, nil, nil)
 
 	assert.NotNil(t, k)
 	assert.Equal(t, msg, k.msg)
 	assert.Equal(t, reader, k.reader)
 }
 
+func TestKafkaMessage_Partition(t *testing.T) {
+	k := newKafkaMessage(&kafka.Message{Topic: "test", Partition: 3}, nil, nil, nil)
+
+	assert.Equal(t, 3, k.Partition())
+}
+
 func TestKafkaMessage_Commit(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -29,7 +35,7 @@ func TestKafkaMessage_Commit(t *testing.T) {
 
 	msg := &kafka.Message{Topic: "test", Value: []byte("hello")}
 	logger := logging.NewMockLogger(logging.ERROR)
-	k := newKafkaMessage(msg, mockReader, logger)
+	k := newKafkaMessage(msg, mockReader, logger, NewMockMetrics(ctrl))
 
 	mockReader.EXPECT().CommitMessages(gomock.Any(), *msg).Return(nil)
 
@@ -41,11 +47,14 @@ func TestKafkaMessage_CommitError(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockReader := NewMockReader(ctrl)
+	mockMetrics := NewMockMetrics(ctrl)
+
+	mockMetrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_commit_failure_count", "topic", "test")
 
 	out := testutil.StderrOutputForFunc(func() {
 		msg := &kafka.Message{Topic: "test", Value: []byte("hello")}
 		logger := logging.NewMockLogger(logging.ERROR)
-		k := newKafkaMessage(msg, mockReader, logger)
+		k := newKafkaMessage(msg, mockReader, logger, mockMetrics)
 
 		mockReader.EXPECT().CommitMessages(gomock.Any(), *msg).
 			Return(testutil.CustomError{ErrorMessage: "error"})
@@ -0,0 +1,209 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Broker holds the topics of the in-memory pub/sub. The clients sharing a broker publish and subscribe to the same
+// topics, e.g. the client of the app and the client of its tests.
+type Broker struct {
+	mu     sync.Mutex
+	topics map[string]*topic
+}
+
+// topic is the log of the messages of a topic, with the offsets of its consumer groups.
+type topic struct {
+	// records are the retained messages of the topic, the first of them at the offset base.
+	records []record
+	base    int64
+	groups  map[string]*group
+	// changed is closed when a message is published to the topic, or the topic is deleted.
+	changed chan struct{}
+}
+
+// group is the position of a consumer group in a topic. Its messages before next are read, and the messages
+// before committed are committed.
+type group struct {
+	next      int64
+	committed int64
+}
+
+type record struct {
+	offset  int64
+	key     string
+	value   []byte
+	headers map[string]string
+	time    time.Time
+}
+
+var defaultBroker = NewBroker()
+
+// NewBroker returns an empty broker.
+func NewBroker() *Broker {
+	return &Broker{topics: make(map[string]*topic)}
+}
+
+// DefaultBroker returns the broker shared by the clients created without a broker, in the process.
+func DefaultBroker() *Broker {
+	return defaultBroker
+}
+
+// topic returns the topic, creating it if it does not exist. It is called holding the lock of the broker.
+func (b *Broker) topic(name string) *topic {
+	t, ok := b.topics[name]
+	if !ok {
+		t = &topic{groups: make(map[string]*group), changed: make(chan struct{})}
+		b.topics[name] = t
+	}
+
+	return t
+}
+
+// group returns the consumer group of the topic, creating it at the first retained message if it does not exist.
+func (t *topic) group(name string) *group {
+	g, ok := t.groups[name]
+	if !ok {
+		g = &group{next: t.base, committed: t.base}
+		t.groups[name] = g
+	}
+
+	return g
+}
+
+func (b *Broker) createTopic(name string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.topic(name)
+}
+
+func (b *Broker) deleteTopic(name string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if t, ok := b.topics[name]; ok {
+		close(t.changed)
+		delete(b.topics, name)
+	}
+}
+
+// publish appends the record to the topic, dropping the oldest records beyond maxMessages.
+func (b *Broker) publish(name string, r record, maxMessages int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	t := b.topic(name)
+
+	r.offset = t.base + int64(len(t.records))
+	t.records = append(t.records, r)
+
+	if maxMessages > 0 && len(t.records) > maxMessages {
+		dropped := len(t.records) - maxMessages
+		t.records = append([]record(nil), t.records[dropped:]...)
+		t.base += int64(dropped)
+	}
+
+	close(t.changed)
+	t.changed = make(chan struct{})
+}
+
+// rewind moves the consumer group back to its committed offset, so that the messages read and not committed are read
+// again, as when a consumer of the group restarts.
+func (b *Broker) rewind(name, groupName string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	g := b.topic(name).group(groupName)
+	g.next = g.committed
+}
+
+// next returns the next message of the topic for the consumer group, waiting for it to be published until ctx is done.
+func (b *Broker) next(ctx context.Context, name, groupName string) (record, error) {
+	for {
+		b.mu.Lock()
+
+		t := b.topic(name)
+		g := t.group(groupName)
+
+		// the messages dropped from the topic are skipped.
+		g.next = max(g.next, t.base)
+
+		if i := g.next - t.base; i < int64(len(t.records)) {
+			g.next++
+
+			r := t.records[i]
+
+			b.mu.Unlock()
+
+			return r, nil
+		}
+
+		changed := t.changed
+
+		b.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return record{}, ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// commit commits the messages of the topic up to the offset, for the consumer group.
+func (b *Broker) commit(name, groupName string, offset int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	t, ok := b.topics[name]
+	if !ok {
+		return
+	}
+
+	g := t.group(groupName)
+	g.committed = max(g.committed, offset+1)
+}
+
+func (b *Broker) committed(name, groupName string) int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	t, ok := b.topics[name]
+	if !ok {
+		return 0
+	}
+
+	return t.group(groupName).committed
+}
+
+// records returns at most limit retained records of the topic, from the offset. It reports whether the topic exists.
+func (b *Broker) records(name string, offset int64, limit int) ([]record, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	t, ok := b.topics[name]
+	if !ok {
+		return nil, false
+	}
+
+	start := min(max(offset-t.base, 0), int64(len(t.records)))
+	end := min(start+int64(limit), int64(len(t.records)))
+
+	return append([]record(nil), t.records[start:end]...), true
+}
+
+// stats returns the number of retained messages of each topic.
+func (b *Broker) stats() map[string]any {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	stats := make(map[string]any, len(b.topics))
+
+	for name, t := range b.topics {
+		stats[name] = map[string]any{"messages": len(t.records)}
+	}
+
+	return stats
+}
@@ -0,0 +1,299 @@
+// Package memory provides an in-memory pub/sub client, to run and test the publishers and subscribers of an app in
+// a single process, without a message broker. The messages are not persisted, and are lost when the process exits.
+package memory
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+
+	"gofr.dev/pkg/gofr/datasource"
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+)
+
+const (
+	// DefaultConsumerGroup is the consumer group of the clients created without one.
+	DefaultConsumerGroup = "gofr"
+	// DefaultMaxMessages is the number of messages retained for each topic, when MaxMessages is not set.
+	DefaultMaxMessages = 10000
+
+	defaultQueryLimit = 10
+)
+
+var (
+	errEmptyTopicName = errors.New("topic name cannot be empty")
+	errTopicNotFound  = errors.New("topic not found")
+	errClientClosed   = errors.New("memory pubsub client is closed")
+)
+
+// Config is the configuration of the in-memory pub/sub client.
+type Config struct {
+	// ConsumerGroupID is the consumer group of the client. Each consumer group reads every message of a topic,
+	// while the clients of the same consumer group share its messages. It defaults to DefaultConsumerGroup.
+	ConsumerGroupID string
+	// MaxMessages is the number of messages retained for each topic, after which the oldest messages are dropped.
+	// It defaults to DefaultMaxMessages.
+	MaxMessages int
+	// Broker holds the topics of the client. It defaults to the broker shared by the clients of the process,
+	// so that the tests of an app publish to and read from the topics of the app.
+	Broker *Broker
+}
+
+// Client is the in-memory pub/sub client. The messages of a topic are read by a consumer group in the order they
+// are published. When a client first subscribes to a topic, its consumer group reads the messages of the topic
+// again from the last committed message, as Kafka consumers do on restart.
+type Client struct {
+	config  Config
+	logger  pubsub.Logger
+	metrics Metrics
+
+	mu sync.Mutex
+	// subscribed are the topics which are subscribed by the client.
+	subscribed map[string]bool
+	closed     bool
+}
+
+// New returns an in-memory pub/sub client, with the defaults set for the missing values of the config.
+func New(conf Config, logger pubsub.Logger, metrics Metrics) *Client {
+	if conf.ConsumerGroupID == "" {
+		conf.ConsumerGroupID = DefaultConsumerGroup
+	}
+
+	if conf.MaxMessages <= 0 {
+		conf.MaxMessages = DefaultMaxMessages
+	}
+
+	if conf.Broker == nil {
+		conf.Broker = defaultBroker
+	}
+
+	logger.Debugf("using in-memory pubsub with consumer group '%s'", conf.ConsumerGroupID)
+
+	return &Client{
+		config:     conf,
+		logger:     logger,
+		metrics:    metrics,
+		subscribed: make(map[string]bool),
+	}
+}
+
+func (c *Client) Publish(ctx context.Context, topic string, message []byte) error {
+	return c.PublishWithOptions(ctx, topic, message, pubsub.PublishOptions{})
+}
+
+// PublishWithOptions publishes the message to the topic, creating the topic if it does not exist. The headers
+// of the options and the trace context are set as the headers of the message.
+func (c *Client) PublishWithOptions(ctx context.Context, topic string, message []byte, options pubsub.PublishOptions) error {
+	ctx, span := otel.GetTracerProvider().Tracer("gofr").Start(ctx, "memory-publish")
+	defer span.End()
+
+	c.metrics.IncrementCounter(ctx, "app_pubsub_publish_total_count", "topic", topic)
+
+	if topic == "" {
+		return errEmptyTopicName
+	}
+
+	if c.isClosed() {
+		return errClientClosed
+	}
+
+	start := time.Now()
+
+	value := make([]byte, len(message))
+	copy(value, message)
+
+	c.config.Broker.publish(topic, record{
+		key:     options.Key,
+		value:   value,
+		headers: copyHeaders(pubsub.InjectTraceContext(ctx, options.AllHeaders())),
+		time:    start,
+	}, c.config.MaxMessages)
+
+	c.logger.Debug(&pubsub.Log{
+		Mode:          "PUB",
+		CorrelationID: span.SpanContext().TraceID().String(),
+		MessageValue:  string(message),
+		Topic:         topic,
+		Host:          "memory",
+		PubSubBackend: "MEMORY",
+		Time:          time.Since(start).Microseconds(),
+	})
+
+	c.metrics.IncrementCounter(ctx, "app_pubsub_publish_success_count", "topic", topic)
+
+	return nil
+}
+
+// Subscribe returns the next message of the topic for the consumer group of the client, waiting for it to be
+// published until ctx is done.
+func (c *Client) Subscribe(ctx context.Context, topic string) (*pubsub.Message, error) {
+	if topic == "" {
+		return nil, errEmptyTopicName
+	}
+
+	if c.isClosed() {
+		return nil, errClientClosed
+	}
+
+	c.mu.Lock()
+
+	if !c.subscribed[topic] {
+		c.subscribed[topic] = true
+		c.config.Broker.rewind(topic, c.config.ConsumerGroupID)
+	}
+
+	c.mu.Unlock()
+
+	ctx, span := otel.GetTracerProvider().Tracer("gofr").Start(ctx, "memory-subscribe")
+	defer span.End()
+
+	c.metrics.IncrementCounter(ctx, "app_pubsub_subscribe_total_count", "topic", topic,
+		"consumer_group", c.config.ConsumerGroupID)
+
+	start := time.Now()
+
+	r, err := c.config.Broker.next(ctx, topic, c.config.ConsumerGroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := pubsub.NewMessage(ctx)
+	msg.Topic = topic
+	msg.Value = r.value
+	msg.Key = r.key
+	msg.SetHeaders(copyHeaders(r.headers))
+	msg.Committer = &committer{broker: c.config.Broker, topic: topic, group: c.config.ConsumerGroupID, offset: r.offset}
+
+	c.logger.Debug(&pubsub.Log{
+		Mode:          "SUB",
+		CorrelationID: span.SpanContext().TraceID().String(),
+		MessageValue:  string(r.value),
+		Topic:         topic,
+		Host:          "memory",
+		PubSubBackend: "MEMORY",
+		Time:          time.Since(start).Microseconds(),
+	})
+
+	c.metrics.IncrementCounter(ctx, "app_pubsub_subscribe_success_count", "topic", topic,
+		"consumer_group", c.config.ConsumerGroupID)
+
+	return msg, nil
+}
+
+// Query returns the values of the retained messages of the topic, separated by new lines. The args are the offset
+// of the first message as int64, 0 by default, and the maximum number of messages as int, 10 by default.
+func (c *Client) Query(_ context.Context, query string, args ...any) ([]byte, error) {
+	if query == "" {
+		return nil, errEmptyTopicName
+	}
+
+	var offset int64
+
+	limit := defaultQueryLimit
+
+	if len(args) > 0 {
+		if val, ok := args[0].(int64); ok {
+			offset = val
+		}
+	}
+
+	if len(args) > 1 {
+		if val, ok := args[1].(int); ok {
+			limit = val
+		}
+	}
+
+	records, ok := c.config.Broker.records(query, offset, limit)
+	if !ok {
+		return nil, errTopicNotFound
+	}
+
+	var result []byte
+
+	for _, r := range records {
+		if len(result) > 0 {
+			result = append(result, '\n')
+		}
+
+		result = append(result, r.value...)
+	}
+
+	return result, nil
+}
+
+// Committed returns the offset up to which the messages of the topic are committed by the consumer group
+// of the client, i.e. the offset of the first message which is not committed.
+func (c *Client) Committed(topic string) int64 {
+	return c.config.Broker.committed(topic, c.config.ConsumerGroupID)
+}
+
+func (c *Client) CreateTopic(_ context.Context, name string) error {
+	if name == "" {
+		return errEmptyTopicName
+	}
+
+	c.config.Broker.createTopic(name)
+
+	return nil
+}
+
+// DeleteTopic deletes the topic with its messages and the offsets of its consumer groups.
+func (c *Client) DeleteTopic(_ context.Context, name string) error {
+	if name == "" {
+		return errEmptyTopicName
+	}
+
+	c.config.Broker.deleteTopic(name)
+
+	return nil
+}
+
+func (c *Client) Health() datasource.Health {
+	res := datasource.Health{
+		Status: datasource.StatusUp,
+		Details: map[string]any{
+			"backend":        "MEMORY",
+			"consumer_group": c.config.ConsumerGroupID,
+			"topics":         c.config.Broker.stats(),
+		},
+	}
+
+	if c.isClosed() {
+		res.Status = datasource.StatusDown
+	}
+
+	return res
+}
+
+// Close closes the client. The topics of its broker are kept, for the other clients of the broker.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.closed = true
+
+	return nil
+}
+
+func (c *Client) isClosed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.closed
+}
+
+func copyHeaders(headers map[string]string) map[string]string {
+	if len(headers) == 0 {
+		return nil
+	}
+
+	c := make(map[string]string, len(headers))
+	for k, v := range headers {
+		c[k] = v
+	}
+
+	return c
+}
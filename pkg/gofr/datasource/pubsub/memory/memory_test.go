@@ -0,0 +1,195 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"gofr.dev/pkg/gofr/datasource"
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+	"gofr.dev/pkg/gofr/logging"
+)
+
+func newTestClient(t *testing.T, conf Config) *Client {
+	t.Helper()
+
+	metrics := NewMockMetrics(gomock.NewController(t))
+	metrics.EXPECT().IncrementCounter(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	return New(conf, logging.NewMockLogger(logging.ERROR), metrics)
+}
+
+func TestClient_PublishSubscribe(t *testing.T) {
+	client := newTestClient(t, Config{Broker: NewBroker()})
+
+	err := client.PublishWithOptions(t.Context(), "orders", []byte(`{"id":1}`), pubsub.PublishOptions{
+		Headers:     map[string]string{"tenant": "gofr"},
+		Key:         "order-1",
+		ContentType: "application/json",
+	})
+	require.NoError(t, err)
+
+	msg, err := client.Subscribe(t.Context(), "orders")
+	require.NoError(t, err)
+
+	assert.Equal(t, "orders", msg.Topic)
+	assert.Equal(t, []byte(`{"id":1}`), msg.Value)
+	assert.Equal(t, "order-1", msg.Key)
+	assert.Equal(t, "gofr", msg.Header("tenant"))
+	assert.Equal(t, "application/json", msg.ContentType())
+	assert.Equal(t, DefaultConsumerGroup, client.config.ConsumerGroupID)
+}
+
+func TestClient_ConsumerGroups(t *testing.T) {
+	broker := NewBroker()
+
+	publisher := newTestClient(t, Config{Broker: broker})
+	first := newTestClient(t, Config{Broker: broker, ConsumerGroupID: "first"})
+	second := newTestClient(t, Config{Broker: broker, ConsumerGroupID: "second"})
+
+	require.NoError(t, publisher.Publish(t.Context(), "orders", []byte("1")))
+	require.NoError(t, publisher.Publish(t.Context(), "orders", []byte("2")))
+
+	for _, client := range []*Client{first, second} {
+		for _, value := range []string{"1", "2"} {
+			msg, err := client.Subscribe(t.Context(), "orders")
+			require.NoError(t, err)
+			assert.Equal(t, value, string(msg.Value), "each consumer group does not read every message")
+		}
+	}
+}
+
+func TestClient_Commit(t *testing.T) {
+	broker := NewBroker()
+	client := newTestClient(t, Config{Broker: broker})
+
+	for _, value := range []string{"1", "2", "3"} {
+		require.NoError(t, client.Publish(t.Context(), "orders", []byte(value)))
+	}
+
+	first, err := client.Subscribe(t.Context(), "orders")
+	require.NoError(t, err)
+
+	second, err := client.Subscribe(t.Context(), "orders")
+	require.NoError(t, err)
+
+	second.Commit()
+	first.Commit()
+
+	assert.Equal(t, int64(2), client.Committed("orders"), "committing a message does not commit the earlier messages")
+	assert.Equal(t, 0, second.Committer.(pubsub.PartitionCommitter).Partition())
+
+	_, err = client.Subscribe(t.Context(), "orders")
+	require.NoError(t, err)
+
+	// a new client of the consumer group reads the messages after the last committed message.
+	restarted := newTestClient(t, Config{Broker: broker})
+
+	msg, err := restarted.Subscribe(t.Context(), "orders")
+	require.NoError(t, err)
+	assert.Equal(t, "3", string(msg.Value), "the message which is not committed is not read again")
+}
+
+func TestClient_SubscribeWaitsForMessage(t *testing.T) {
+	client := newTestClient(t, Config{Broker: NewBroker()})
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+
+		_ = client.Publish(context.Background(), "orders", []byte("1"))
+	}()
+
+	msg, err := client.Subscribe(t.Context(), "orders")
+	require.NoError(t, err)
+	assert.Equal(t, "1", string(msg.Value))
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = client.Subscribe(ctx, "orders")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestClient_MaxMessages(t *testing.T) {
+	client := newTestClient(t, Config{Broker: NewBroker(), MaxMessages: 2})
+
+	for _, value := range []string{"1", "2", "3"} {
+		require.NoError(t, client.Publish(t.Context(), "orders", []byte(value)))
+	}
+
+	msg, err := client.Subscribe(t.Context(), "orders")
+	require.NoError(t, err)
+	assert.Equal(t, "2", string(msg.Value), "the oldest message is not dropped")
+
+	result, err := client.Query(t.Context(), "orders")
+	require.NoError(t, err)
+	assert.Equal(t, "2\n3", string(result))
+}
+
+func TestClient_Query(t *testing.T) {
+	client := newTestClient(t, Config{Broker: NewBroker()})
+
+	for _, value := range []string{"1", "2", "3"} {
+		require.NoError(t, client.Publish(t.Context(), "orders", []byte(value)))
+	}
+
+	result, err := client.Query(t.Context(), "orders", int64(1), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "2", string(result))
+
+	result, err = client.Query(t.Context(), "orders", int64(5))
+	require.NoError(t, err)
+	assert.Empty(t, result)
+
+	_, err = client.Query(t.Context(), "payments")
+	require.ErrorIs(t, err, errTopicNotFound)
+
+	_, err = client.Query(t.Context(), "")
+	require.ErrorIs(t, err, errEmptyTopicName)
+}
+
+func TestClient_Topics(t *testing.T) {
+	client := newTestClient(t, Config{Broker: NewBroker()})
+
+	require.NoError(t, client.CreateTopic(t.Context(), "orders"))
+	require.NoError(t, client.Publish(t.Context(), "orders", []byte("1")))
+
+	assert.Equal(t, map[string]any{"orders": map[string]any{"messages": 1}}, client.Health().Details["topics"])
+
+	require.NoError(t, client.DeleteTopic(t.Context(), "orders"))
+
+	_, err := client.Query(t.Context(), "orders")
+	require.ErrorIs(t, err, errTopicNotFound)
+
+	require.ErrorIs(t, client.CreateTopic(t.Context(), ""), errEmptyTopicName)
+	require.ErrorIs(t, client.DeleteTopic(t.Context(), ""), errEmptyTopicName)
+	require.ErrorIs(t, client.Publish(t.Context(), "", []byte("1")), errEmptyTopicName)
+
+	_, err = client.Subscribe(t.Context(), "")
+	require.ErrorIs(t, err, errEmptyTopicName)
+}
+
+func TestClient_HealthAndClose(t *testing.T) {
+	client := newTestClient(t, Config{Broker: NewBroker(), ConsumerGroupID: "orders-service"})
+
+	health := client.Health()
+	assert.Equal(t, datasource.StatusUp, health.Status)
+	assert.Equal(t, "MEMORY", health.Details["backend"])
+	assert.Equal(t, "orders-service", health.Details["consumer_group"])
+
+	require.NoError(t, client.Close())
+
+	assert.Equal(t, datasource.StatusDown, client.Health().Status)
+	require.ErrorIs(t, client.Publish(t.Context(), "orders", []byte("1")), errClientClosed)
+
+	_, err := client.Subscribe(t.Context(), "orders")
+	require.ErrorIs(t, err, errClientClosed)
+}
+
+func TestNew_DefaultBroker(t *testing.T) {
+	assert.Same(t, DefaultBroker(), newTestClient(t, Config{}).config.Broker)
+}
@@ -0,0 +1,20 @@
+package memory
+
+// committer commits a message of a topic for a consumer group. Committing a message commits the earlier messages
+// of the topic as well, as in a Kafka partition.
+type committer struct {
+	broker *Broker
+	topic  string
+	group  string
+	offset int64
+}
+
+func (c *committer) Commit() {
+	c.broker.commit(c.topic, c.group, c.offset)
+}
+
+// Partition returns 0, the topics having a single partition, so that the messages handled concurrently
+// are committed in order.
+func (*committer) Partition() int {
+	return 0
+}
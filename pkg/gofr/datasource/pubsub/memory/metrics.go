@@ -0,0 +1,7 @@
+package memory
+
+import "context"
+
+type Metrics interface {
+	IncrementCounter(ctx context.Context, name string, labels ...string)
+}
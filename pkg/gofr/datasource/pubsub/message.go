@@ -6,17 +6,26 @@ import (
 	"errors"
 	"reflect"
 	"strconv"
+	"strings"
 )
 
+// ContentTypeHeader is the header carrying the content type of a message.
+const ContentTypeHeader = "content-type"
+
 var errNotPointer = errors.New("input should be a pointer to a variable")
 
 type Message struct {
 	ctx context.Context
 
-	Topic    string
-	Value    []byte
+	Topic string
+	Value []byte
+	// Key is the partition key of the message in Kafka and Event Hub, or its ordering key in Google PubSub.
+	Key      string
 	MetaData any
 
+	headers map[string]string
+	codec   Codec
+
 	Committer
 }
 
@@ -32,6 +41,47 @@ func (m *Message) Context() context.Context {
 	return m.ctx
 }
 
+// Headers returns the headers of the message, i.e. its headers, attributes or properties as per the broker.
+func (m *Message) Headers() map[string]string {
+	return m.headers
+}
+
+// Header returns the value of the header of the message, matching the name case-insensitively
+// when the header is not found with the exact name.
+func (m *Message) Header(name string) string {
+	if v, ok := m.headers[name]; ok {
+		return v
+	}
+
+	for k, v := range m.headers {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// ContentType returns the content type of the message, set in its content-type header.
+func (m *Message) ContentType() string {
+	return m.Header(ContentTypeHeader)
+}
+
+// SetContext sets the context of the received message, e.g. with the span of the subscriber handling it.
+func (m *Message) SetContext(ctx context.Context) {
+	m.ctx = ctx
+}
+
+// SetHeaders sets the headers of the received message, it is used by the pub/sub clients.
+func (m *Message) SetHeaders(headers map[string]string) {
+	m.headers = headers
+}
+
+// SetCodec sets the codec with which Bind decodes the message, it is used by the subscriptions.
+func (m *Message) SetCodec(codec Codec) {
+	m.codec = codec
+}
+
 func (m *Message) Param(p string) string {
 	if p == "topic" {
 		return m.Topic
@@ -45,11 +95,16 @@ func (m *Message) PathParam(p string) string {
 }
 
 // Bind binds the message value to the input variable. The input should be a pointer to a variable.
+// The message value is decoded with the codec of the message when it is set.
 func (m *Message) Bind(i any) error {
 	if reflect.ValueOf(i).Kind() != reflect.Ptr {
 		return errNotPointer
 	}
 
+	if m.codec != nil {
+		return m.codec.Decode(m.Context(), m.Topic, m.Value, i)
+	}
+
 	switch v := i.(type) {
 	case *string:
 		return m.bindString(v)
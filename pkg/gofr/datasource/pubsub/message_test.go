@@ -242,3 +242,38 @@ func TestMessage_QueryParam(t *testing.T) {
 
 	assert.Nil(t, m.Params("test"))
 }
+
+func TestMessage_Headers(t *testing.T) {
+	m := NewMessage(t.Context())
+
+	assert.Nil(t, m.Headers())
+	assert.Empty(t, m.ContentType())
+
+	m.SetHeaders(map[string]string{"Tenant": "gofr", "Content-Type": "application/json"})
+
+	assert.Equal(t, map[string]string{"Tenant": "gofr", "Content-Type": "application/json"}, m.Headers())
+	assert.Equal(t, "gofr", m.Header("Tenant"))
+	assert.Equal(t, "gofr", m.Header("tenant"))
+	assert.Empty(t, m.Header("missing"))
+	assert.Equal(t, "application/json", m.ContentType())
+}
+
+func TestPublishOptions(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		options PublishOptions
+		headers map[string]string
+		empty   bool
+	}{
+		{desc: "no options", headers: nil, empty: true},
+		{desc: "key", options: PublishOptions{Key: "k"}, headers: nil},
+		{desc: "headers", options: PublishOptions{Headers: map[string]string{"a": "1"}}, headers: map[string]string{"a": "1"}},
+		{desc: "headers and content type", options: PublishOptions{Headers: map[string]string{"a": "1"}, ContentType: "text/plain"},
+			headers: map[string]string{"a": "1", ContentTypeHeader: "text/plain"}},
+	}
+
+	for i, tc := range testCases {
+		assert.Equal(t, tc.headers, tc.options.AllHeaders(), "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.empty, tc.options.IsEmpty(), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
@@ -53,6 +53,8 @@ func (m *MQTT) createQueryMessageHandler(ctx context.Context, msgChan chan<- *pu
 			"messageID": strconv.Itoa(int(msg.MessageID())),
 		}
 
+		setMessageProperties(message, msg)
+
 		select {
 		case msgChan <- message:
 		default:
@@ -153,6 +155,8 @@ func (m *MQTT) createMqttHandler(_ context.Context, topic string, msgs chan *pub
 			"messageID": strconv.Itoa(int(msg.MessageID())),
 		}
 
+		setMessageProperties(messg, msg)
+
 		messg.Committer = &message{msg: msg}
 
 		// store the message in the channel
@@ -181,6 +185,8 @@ func getHandler(subscribeFunc SubscribeFunc) func(client mqtt.Client, msg mqtt.M
 			},
 		}
 
+		setMessageProperties(pubsubMsg, msg)
+
 		// call the user defined function
 		_ = subscribeFunc(pubsubMsg)
 	}
@@ -29,6 +29,9 @@ var (
 	errSubscriptionTimeout = errors.New("timed out waiting for MQTT subscription")
 	errSubscriptionFailed  = errors.New("failed to subscribe to MQTT topic")
 	errQueryCancelled      = errors.New("query canceled")
+	// errPublishOptionsNotSupported is returned as the MQTT v3.1.1 messages have no properties to carry the
+	// headers, key and content type.
+	errPublishOptionsNotSupported = errors.New("headers, key and content type are not supported by MQTT v3.1.1")
 )
 
 type SubscribeFunc func(*pubsub.Message) error
@@ -59,6 +62,9 @@ type Config struct {
 	RetrieveRetained bool
 	KeepAlive        time.Duration
 	CloseTimeout     time.Duration
+	// ProtocolVersion is ProtocolVersion5 to connect over MQTT v5, whose messages carry the headers, key and content
+	// type; the client connects over MQTT v3.1.1 otherwise.
+	ProtocolVersion int
 }
 
 type subscription struct {
@@ -79,11 +85,17 @@ func New(config *Config, logger Logger, metrics Metrics) *MQTT {
 
 	logger.Debugf("connecting to MQTT at '%v:%v' with clientID '%v'", config.Hostname, config.Port, config.ClientID)
 
-	options.SetOnConnectHandler(createReconnectHandler(mu, config, subs, logger))
-	options.SetConnectionLostHandler(createConnectionLostHandler(logger))
-	options.SetReconnectingHandler(createReconnectingHandler(logger, config))
-	// create the client using the options above
-	client := mqtt.NewClient(options)
+	var client mqtt.Client
+
+	if config.ProtocolVersion == ProtocolVersion5 {
+		client = newClientV5(config, options, logger, createReconnectHandler(mu, config, subs, logger))
+	} else {
+		options.SetOnConnectHandler(createReconnectHandler(mu, config, subs, logger))
+		options.SetConnectionLostHandler(createConnectionLostHandler(logger))
+		options.SetReconnectingHandler(createReconnectingHandler(logger, config))
+		// create the client using the options above
+		client = mqtt.NewClient(options)
+	}
 
 	if token := client.Connect(); token.Wait() && token.Error() != nil {
 		logger.Errorf("could not connect to MQTT at '%v:%v', error: %v", config.Hostname, config.Port, token.Error())
@@ -176,15 +188,39 @@ func (m *MQTT) Query(ctx context.Context, query string, args ...any) ([]byte, er
 	return resultBuffer.Bytes(), nil
 }
 
+// Publish publishes the message to the topic. The trace context is sent in the user properties of the MQTT v5
+// messages; it is not propagated to the subscribers over MQTT v3.1.1, whose messages have no properties to carry it.
 func (m *MQTT) Publish(ctx context.Context, topic string, message []byte) error {
-	_, span := otel.GetTracerProvider().Tracer("gofr").Start(ctx, "mqtt-publish")
+	return m.publish(ctx, topic, message, &pubsub.PublishOptions{})
+}
+
+// PublishWithOptions publishes the message with the headers, key and content type as the properties of the MQTT v5
+// messages. The MQTT v3.1.1 messages have no properties, so it returns an error for MQTT v3.1.1 when any option is set.
+func (m *MQTT) PublishWithOptions(ctx context.Context, topic string, message []byte, options pubsub.PublishOptions) error {
+	if _, ok := m.Client.(propertiesPublisher); !ok && !options.IsEmpty() {
+		return errPublishOptionsNotSupported
+	}
+
+	return m.publish(ctx, topic, message, &options)
+}
+
+func (m *MQTT) publish(ctx context.Context, topic string, message []byte, options *pubsub.PublishOptions) error {
+	spanCtx, span := otel.GetTracerProvider().Tracer("gofr").Start(ctx, "mqtt-publish")
 	defer span.End()
 
 	m.metrics.IncrementCounter(ctx, "app_pubsub_publish_total_count", "topic", topic)
 
 	s := time.Now()
 
-	token := m.Client.Publish(topic, m.config.QoS, m.config.RetrieveRetained, message)
+	var token mqtt.Token
+
+	if client, ok := m.Client.(propertiesPublisher); ok {
+		options.Headers = pubsub.InjectTraceContext(spanCtx, options.Headers)
+
+		token = client.publishWithProperties(topic, m.config.QoS, m.config.RetrieveRetained, message, publishProperties(options))
+	} else {
+		token = m.Client.Publish(topic, m.config.QoS, m.config.RetrieveRetained, message)
+	}
 
 	// Check for errors during publishing (More on error reporting
 	// https://pkg.go.dev/github.com/eclipse/paho.mqtt.golang#readme-error-handling)
@@ -221,6 +221,25 @@ func TestMQTT_PublishFailure(t *testing.T) {
 	require.ErrorIs(t, err, errToken)
 }
 
+func TestMQTT_PublishWithOptions(t *testing.T) {
+	ctrl, client, mockClient, mockMetrics, mockToken := getMockMQTT(t, mockConfigs)
+	defer ctrl.Finish()
+
+	ctx := t.Context()
+
+	err := client.PublishWithOptions(ctx, "test/topic", msg, pubsub.PublishOptions{Key: "order-1"})
+	require.ErrorIs(t, err, errPublishOptionsNotSupported)
+
+	mockMetrics.EXPECT().IncrementCounter(ctx, "app_pubsub_publish_total_count", "topic", "test/topic")
+	mockMetrics.EXPECT().IncrementCounter(ctx, "app_pubsub_publish_success_count", "topic", "test/topic")
+	mockClient.EXPECT().Publish("test/topic", mockConfigs.QoS, mockConfigs.RetrieveRetained, msg).Return(mockToken)
+	mockToken.EXPECT().Wait().Return(true)
+	mockToken.EXPECT().Error().Return(nil)
+
+	err = client.PublishWithOptions(ctx, "test/topic", msg, pubsub.PublishOptions{})
+	require.NoError(t, err)
+}
+
 func TestMQTT_SubscribeSuccess(t *testing.T) {
 	ctrl, client, mockClient, mockMetrics, mockToken := getMockMQTT(t, mockConfigs)
 	defer ctrl.Finish()
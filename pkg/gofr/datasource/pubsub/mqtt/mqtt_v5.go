@@ -0,0 +1,408 @@
+package mqtt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/eclipse/paho.golang/autopaho"
+	"github.com/eclipse/paho.golang/paho"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+)
+
+const (
+	// ProtocolVersion5 is the Config.ProtocolVersion connecting to the broker over MQTT v5, whose messages carry
+	// the headers, key and content type as properties.
+	ProtocolVersion5 = 5
+	// KeyProperty is the user property carrying the key of the MQTT v5 messages, MQTT messages having no key.
+	KeyProperty = "x-message-key"
+)
+
+var errInvalidPayload = errors.New("payload of MQTT message must be []byte or string")
+
+// propertiesPublisher is the mqtt.Client publishing the messages with properties, i.e. the MQTT v5 client.
+type propertiesPublisher interface {
+	publishWithProperties(topic string, qos byte, retained bool, payload []byte, properties *paho.PublishProperties) mqtt.Token
+}
+
+// clientV5 is the mqtt.Client connecting to the broker over MQTT v5, so that the MQTT pub/sub publishes and
+// subscribes with the same client for both the protocol versions.
+type clientV5 struct {
+	config    *Config
+	options   *mqtt.ClientOptions
+	logger    Logger
+	onConnect mqtt.OnConnectHandler
+	router    *paho.StandardRouter
+
+	// attemptConnection establishes the network connection to the broker when set, e.g. in the tests.
+	attemptConnection func(context.Context, autopaho.ClientConfig, *url.URL) (net.Conn, error)
+
+	mu        sync.RWMutex
+	cm        *autopaho.ConnectionManager
+	connected bool
+}
+
+func newClientV5(config *Config, options *mqtt.ClientOptions, logger Logger, onConnect mqtt.OnConnectHandler) *clientV5 {
+	return &clientV5{
+		config:    config,
+		options:   options,
+		logger:    logger,
+		onConnect: onConnect,
+		router:    paho.NewStandardRouter(),
+	}
+}
+
+func (c *clientV5) IsConnected() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.connected
+}
+
+func (c *clientV5) IsConnectionOpen() bool {
+	return c.IsConnected()
+}
+
+// Connect starts connecting to the broker, the connection being reestablished whenever it is lost, and completes
+// when the connection is up.
+func (c *clientV5) Connect() mqtt.Token {
+	return newToken(func() error {
+		c.mu.Lock()
+
+		if c.cm == nil {
+			cfg, err := c.clientConfig()
+			if err != nil {
+				c.mu.Unlock()
+
+				return err
+			}
+
+			c.cm, err = autopaho.NewConnection(context.Background(), cfg)
+			if err != nil {
+				c.mu.Unlock()
+
+				return err
+			}
+		}
+
+		cm := c.cm
+
+		c.mu.Unlock()
+
+		ctx, cancel := context.WithTimeout(context.Background(), defaultRetryTimeout)
+		defer cancel()
+
+		return cm.AwaitConnection(ctx)
+	})
+}
+
+func (c *clientV5) clientConfig() (autopaho.ClientConfig, error) {
+	serverURL, err := url.Parse(fmt.Sprintf("%s://%s:%d", c.config.Protocol, c.config.Hostname, c.config.Port))
+	if err != nil {
+		return autopaho.ClientConfig{}, err
+	}
+
+	return autopaho.ClientConfig{
+		ServerUrls:        []*url.URL{serverURL},
+		KeepAlive:         uint16(math.Min(c.config.KeepAlive.Seconds(), math.MaxUint16)),
+		ConnectUsername:   c.config.Username,
+		ConnectPassword:   []byte(c.config.Password),
+		AttemptConnection: c.attemptConnection,
+		OnConnectionUp: func(*autopaho.ConnectionManager, *paho.Connack) {
+			c.setConnected(true)
+
+			go c.onConnect(c)
+		},
+		OnConnectionDown: func() bool {
+			c.setConnected(false)
+			c.logger.Errorf("mqtt connection lost")
+
+			return true
+		},
+		OnConnectError: func(err error) {
+			c.logger.Errorf("could not connect to MQTT at '%v:%v', error: %v", c.config.Hostname, c.config.Port, err)
+		},
+		ClientConfig: paho.ClientConfig{
+			ClientID: c.options.ClientID,
+			OnPublishReceived: []func(paho.PublishReceived) (bool, error){
+				func(received paho.PublishReceived) (bool, error) {
+					c.router.Route(received.Packet.Packet())
+
+					return true, nil
+				},
+			},
+		},
+	}, nil
+}
+
+func (c *clientV5) setConnected(connected bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.connected = connected
+}
+
+func (c *clientV5) connection() (*autopaho.ConnectionManager, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.cm == nil {
+		return nil, errClientNotConnected
+	}
+
+	return c.cm, nil
+}
+
+// Disconnect closes the connection, waiting for the in-flight operations for quiesce milliseconds.
+func (c *clientV5) Disconnect(quiesce uint) {
+	cm, err := c.connection()
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(quiesce)*time.Millisecond)
+	defer cancel()
+
+	if err := cm.Disconnect(ctx); err != nil {
+		c.logger.Errorf("error while disconnecting from MQTT, error: %v", err)
+	}
+
+	c.setConnected(false)
+}
+
+func (c *clientV5) Publish(topic string, qos byte, retained bool, payload any) mqtt.Token {
+	var message []byte
+
+	switch p := payload.(type) {
+	case []byte:
+		message = p
+	case string:
+		message = []byte(p)
+	default:
+		return newToken(func() error { return errInvalidPayload })
+	}
+
+	return c.publishWithProperties(topic, qos, retained, message, nil)
+}
+
+func (c *clientV5) publishWithProperties(topic string, qos byte, retained bool, payload []byte,
+	properties *paho.PublishProperties) mqtt.Token {
+	return newToken(func() error {
+		cm, err := c.connection()
+		if err != nil {
+			return err
+		}
+
+		_, err = cm.Publish(context.Background(), &paho.Publish{
+			QoS:        qos,
+			Retain:     retained,
+			Topic:      topic,
+			Properties: properties,
+			Payload:    payload,
+		})
+
+		return err
+	})
+}
+
+func (c *clientV5) Subscribe(topic string, qos byte, callback mqtt.MessageHandler) mqtt.Token {
+	return c.SubscribeMultiple(map[string]byte{topic: qos}, callback)
+}
+
+func (c *clientV5) SubscribeMultiple(filters map[string]byte, callback mqtt.MessageHandler) mqtt.Token {
+	return newToken(func() error {
+		cm, err := c.connection()
+		if err != nil {
+			return err
+		}
+
+		subscribe := &paho.Subscribe{Subscriptions: make([]paho.SubscribeOptions, 0, len(filters))}
+
+		for topic, qos := range filters {
+			c.AddRoute(topic, callback)
+
+			subscribe.Subscriptions = append(subscribe.Subscriptions, paho.SubscribeOptions{Topic: topic, QoS: qos})
+		}
+
+		_, err = cm.Subscribe(context.Background(), subscribe)
+
+		return err
+	})
+}
+
+func (c *clientV5) Unsubscribe(topics ...string) mqtt.Token {
+	return newToken(func() error {
+		for _, topic := range topics {
+			c.router.UnregisterHandler(topic)
+		}
+
+		cm, err := c.connection()
+		if err != nil {
+			return err
+		}
+
+		_, err = cm.Unsubscribe(context.Background(), &paho.Unsubscribe{Topics: topics})
+
+		return err
+	})
+}
+
+// AddRoute sets the handler of the messages of the topic, replacing its earlier handler, e.g. when the topic is
+// subscribed to again after reconnecting.
+func (c *clientV5) AddRoute(topic string, callback mqtt.MessageHandler) {
+	c.router.UnregisterHandler(topic)
+	c.router.RegisterHandler(topic, func(p *paho.Publish) {
+		callback(c, &messageV5{publish: p})
+	})
+}
+
+func (c *clientV5) OptionsReader() mqtt.ClientOptionsReader {
+	return mqtt.NewOptionsReader(c.options)
+}
+
+// messageV5 is the mqtt.Message received over MQTT v5, whose properties carry its headers, key and content type.
+type messageV5 struct {
+	publish *paho.Publish
+}
+
+func (m *messageV5) Duplicate() bool {
+	return m.publish.Duplicate()
+}
+
+func (m *messageV5) Qos() byte {
+	return m.publish.QoS
+}
+
+func (m *messageV5) Retained() bool {
+	return m.publish.Retain
+}
+
+func (m *messageV5) Topic() string {
+	return m.publish.Topic
+}
+
+func (m *messageV5) MessageID() uint16 {
+	return m.publish.PacketID
+}
+
+func (m *messageV5) Payload() []byte {
+	return m.publish.Payload
+}
+
+// Ack is a no-op, as the messages are acknowledged once they are handled.
+func (*messageV5) Ack() {}
+
+// key returns the key of the message, carried by its KeyProperty user property.
+func (m *messageV5) key() string {
+	if m.publish.Properties == nil {
+		return ""
+	}
+
+	return m.publish.Properties.User.Get(KeyProperty)
+}
+
+// headers returns the user properties of the message, with its content type in the content-type header.
+func (m *messageV5) headers() map[string]string {
+	properties := m.publish.Properties
+	if properties == nil || (len(properties.User) == 0 && properties.ContentType == "") {
+		return nil
+	}
+
+	headers := make(map[string]string, len(properties.User)+1)
+
+	for _, p := range properties.User {
+		if p.Key == KeyProperty {
+			continue
+		}
+
+		headers[p.Key] = p.Value
+	}
+
+	if properties.ContentType != "" {
+		headers[pubsub.ContentTypeHeader] = properties.ContentType
+	}
+
+	return headers
+}
+
+// publishProperties returns the properties of the MQTT v5 message published with the options.
+func publishProperties(options *pubsub.PublishOptions) *paho.PublishProperties {
+	properties := &paho.PublishProperties{ContentType: options.ContentType}
+
+	for k, v := range options.Headers {
+		properties.User.Add(k, v)
+	}
+
+	if options.Key != "" {
+		properties.User.Add(KeyProperty, options.Key)
+	}
+
+	return properties
+}
+
+// setMessageProperties sets the headers and key of the message received over MQTT v5.
+func setMessageProperties(message *pubsub.Message, msg mqtt.Message) {
+	m, ok := msg.(*messageV5)
+	if !ok {
+		return
+	}
+
+	message.Key = m.key()
+	message.SetHeaders(m.headers())
+}
+
+// token is the mqtt.Token of the operations of the MQTT v5 client, which run in the background.
+type token struct {
+	done chan struct{}
+	err  error
+}
+
+func newToken(operation func() error) *token {
+	t := &token{done: make(chan struct{})}
+
+	go func() {
+		t.err = operation()
+
+		close(t.done)
+	}()
+
+	return t
+}
+
+func (t *token) Wait() bool {
+	<-t.done
+
+	return true
+}
+
+func (t *token) WaitTimeout(timeout time.Duration) bool {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-t.done:
+		return true
+	case <-timer.C:
+		return false
+	}
+}
+
+func (t *token) Done() <-chan struct{} {
+	return t.done
+}
+
+func (t *token) Error() error {
+	select {
+	case <-t.done:
+		return t.err
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,221 @@
+package mqtt
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/eclipse/paho.golang/autopaho"
+	"github.com/eclipse/paho.golang/packets"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+	"gofr.dev/pkg/gofr/logging"
+)
+
+// serveBroker serves the client on the connection as an MQTT v5 broker, acknowledging its packets and delivering
+// the messages it publishes back to it.
+func serveBroker(conn net.Conn) {
+	defer conn.Close()
+
+	for {
+		cp, err := packets.ReadPacket(conn)
+		if err != nil {
+			return
+		}
+
+		var reply io.WriterTo
+
+		switch p := cp.Content.(type) {
+		case *packets.Connect:
+			reply = &packets.Connack{Properties: &packets.Properties{}}
+		case *packets.Subscribe:
+			reply = &packets.Suback{PacketID: p.PacketID, Reasons: make([]byte, len(p.Subscriptions)),
+				Properties: &packets.Properties{}}
+		case *packets.Unsubscribe:
+			reply = &packets.Unsuback{PacketID: p.PacketID, Reasons: make([]byte, len(p.Topics)),
+				Properties: &packets.Properties{}}
+		case *packets.Publish:
+			if p.QoS > 0 {
+				if _, err := (&packets.Puback{PacketID: p.PacketID, Properties: &packets.Properties{}}).WriteTo(conn); err != nil {
+					return
+				}
+			}
+
+			reply = &packets.Publish{Topic: p.Topic, Payload: p.Payload, Properties: p.Properties}
+		case *packets.Pingreq:
+			reply = &packets.Pingresp{}
+		case *packets.Disconnect:
+			return
+		default:
+			continue
+		}
+
+		if _, err := reply.WriteTo(conn); err != nil {
+			return
+		}
+	}
+}
+
+func getTestMQTTV5(t *testing.T) (*MQTT, *MockMetrics) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockMetrics := NewMockMetrics(ctrl)
+	logger := logging.NewMockLogger(logging.DEBUG)
+
+	conf := *mockConfigs
+	conf.ProtocolVersion = ProtocolVersion5
+
+	client := newClientV5(&conf, getMQTTClientOptions(&conf), logger, func(mqtt.Client) {})
+	client.attemptConnection = func(context.Context, autopaho.ClientConfig, *url.URL) (net.Conn, error) {
+		clientConn, brokerConn := net.Pipe()
+
+		go serveBroker(brokerConn)
+
+		return packets.NewThreadSafeConn(clientConn), nil
+	}
+
+	token := client.Connect()
+	require.True(t, token.WaitTimeout(time.Second))
+	require.NoError(t, token.Error())
+
+	t.Cleanup(func() { client.Disconnect(100) })
+
+	return &MQTT{client, logger, mockMetrics, &conf, make(map[string]subscription), &sync.RWMutex{}}, mockMetrics
+}
+
+func TestMQTT_PublishWithOptions_V5(t *testing.T) {
+	m, mockMetrics := getTestMQTTV5(t)
+
+	mockMetrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_publish_total_count", "topic", "orders").Times(2)
+	mockMetrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_publish_success_count", "topic", "orders").Times(2)
+
+	messages := make(chan *pubsub.Message, 2)
+
+	require.NoError(t, m.SubscribeWithFunction("orders", func(msg *pubsub.Message) error {
+		messages <- msg
+
+		return nil
+	}))
+
+	err := m.PublishWithOptions(t.Context(), "orders", msg, pubsub.PublishOptions{
+		Headers:     map[string]string{"tenant": "gofr"},
+		Key:         "order-1",
+		ContentType: "application/json",
+	})
+	require.NoError(t, err)
+
+	received := <-messages
+
+	assert.Equal(t, msg, received.Value)
+	assert.Equal(t, "order-1", received.Key)
+	assert.Equal(t, map[string]string{"tenant": "gofr", pubsub.ContentTypeHeader: "application/json"}, received.Headers())
+
+	require.NoError(t, m.Publish(t.Context(), "orders", msg))
+
+	received = <-messages
+
+	assert.Equal(t, msg, received.Value)
+	assert.Empty(t, received.Key)
+	assert.Empty(t, received.Headers())
+}
+
+func TestMQTT_Publish_V5TraceContext(t *testing.T) {
+	tracerProvider := otel.GetTracerProvider()
+
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	otel.SetTracerProvider(sdktrace.NewTracerProvider())
+
+	t.Cleanup(func() {
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+		otel.SetTracerProvider(tracerProvider)
+	})
+
+	m, mockMetrics := getTestMQTTV5(t)
+
+	mockMetrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_publish_total_count", "topic", "orders")
+	mockMetrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_publish_success_count", "topic", "orders")
+
+	messages := make(chan *pubsub.Message, 1)
+
+	require.NoError(t, m.SubscribeWithFunction("orders", func(msg *pubsub.Message) error {
+		messages <- msg
+
+		return nil
+	}))
+
+	ctx, span := otel.Tracer("test").Start(t.Context(), "handler")
+	defer span.End()
+
+	require.NoError(t, m.Publish(ctx, "orders", msg))
+
+	received := <-messages
+
+	extracted := trace.SpanContextFromContext(pubsub.ExtractTraceContext(t.Context(), received.Headers()))
+
+	assert.True(t, extracted.IsRemote())
+	assert.Equal(t, span.SpanContext().TraceID(), extracted.TraceID(), "the subscriber continues the trace of the publisher")
+}
+
+func TestClientV5_Unsubscribe(t *testing.T) {
+	m, _ := getTestMQTTV5(t)
+	client := m.Client.(*clientV5)
+
+	messages := make(chan mqtt.Message, 2)
+	handler := func(_ mqtt.Client, msg mqtt.Message) { messages <- msg }
+
+	token := client.Subscribe("orders/+", 0, handler)
+	require.True(t, token.Wait())
+	require.NoError(t, token.Error())
+
+	// the handler of the topic is replaced when it is subscribed to again, e.g. after reconnecting.
+	token = client.Subscribe("orders/+", 0, handler)
+	require.True(t, token.Wait())
+	require.NoError(t, token.Error())
+
+	token = client.Publish("orders/1", 0, false, "1")
+	require.True(t, token.Wait())
+	require.NoError(t, token.Error())
+
+	received := <-messages
+
+	assert.Equal(t, "orders/1", received.Topic())
+	assert.Equal(t, []byte("1"), received.Payload())
+
+	token = client.Unsubscribe("orders/+")
+	require.True(t, token.Wait())
+	require.NoError(t, token.Error())
+
+	token = client.Publish("orders/2", 0, false, []byte("2"))
+	require.True(t, token.Wait())
+	require.NoError(t, token.Error())
+
+	token = client.Publish("orders/3", 0, false, 3)
+	require.True(t, token.Wait())
+	require.ErrorIs(t, token.Error(), errInvalidPayload)
+
+	// the messages are delivered in order, so that orders/2 is handled once the message of the other topic is.
+	token = client.Subscribe("payments", 0, handler)
+	require.True(t, token.Wait())
+	require.NoError(t, token.Error())
+
+	token = client.Publish("payments", 0, false, "4")
+	require.True(t, token.Wait())
+	require.NoError(t, token.Error())
+
+	received = <-messages
+
+	assert.Equal(t, "payments", received.Topic(), "the messages are delivered once, and not after unsubscribing")
+}
@@ -96,6 +96,15 @@ func (c *Client) Publish(ctx context.Context, subject string, message []byte) er
 	return c.connManager.Publish(ctx, subject, message, c.metrics)
 }
 
+// PublishWithOptions publishes a message to a topic with the headers, key and content type of the options.
+func (c *Client) PublishWithOptions(ctx context.Context, subject string, message []byte, options pubsub.PublishOptions) error {
+	if err := checkClient(c); err != nil {
+		return err
+	}
+
+	return c.connManager.PublishWithOptions(ctx, subject, message, options, c.metrics)
+}
+
 // Subscribe subscribes to a topic and returns a single message.
 func (c *Client) Subscribe(ctx context.Context, topic string) (*pubsub.Message, error) {
 	for {
@@ -1,6 +1,7 @@
 package nats
 
 import (
+	"context"
 	"log"
 
 	"github.com/nats-io/nats.go/jetstream"
@@ -8,7 +9,9 @@ import (
 
 // natsCommitter implements the pubsub.Committer interface for Client messages.
 type natsCommitter struct {
-	msg jetstream.Msg
+	msg     jetstream.Msg
+	topic   string
+	metrics Metrics
 }
 
 // Commit commits the message.
@@ -16,6 +19,8 @@ func (c *natsCommitter) Commit() {
 	if err := c.msg.Ack(); err != nil {
 		log.Println("Error committing message:", err)
 
+		c.metrics.IncrementCounter(context.Background(), "app_pubsub_commit_failure_count", "topic", c.topic)
+
 		// nak the message
 		if err := c.msg.Nak(); err != nil {
 			log.Println("Error naking message:", err)
@@ -9,8 +9,8 @@ import (
 )
 
 // createTestCommitter is a helper function for tests to create a natsCommitter.
-func createTestCommitter(msg jetstream.Msg) *natsCommitter {
-	return &natsCommitter{msg: msg}
+func createTestCommitter(msg jetstream.Msg, metrics Metrics) *natsCommitter {
+	return &natsCommitter{msg: msg, topic: "test.topic", metrics: metrics}
 }
 
 func TestNATSCommitter_Commit(t *testing.T) {
@@ -18,7 +18,8 @@ func TestNATSCommitter_Commit(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockMsg := NewMockMsg(ctrl)
-	committer := createTestCommitter(mockMsg)
+	mockMetrics := NewMockMetrics(ctrl)
+	committer := createTestCommitter(mockMsg, mockMetrics)
 
 	t.Run("Successful Commit", func(_ *testing.T) {
 		mockMsg.EXPECT().Ack().Return(nil)
@@ -28,6 +29,7 @@ func TestNATSCommitter_Commit(t *testing.T) {
 
 	t.Run("Failed Commit with Successful Nak", func(_ *testing.T) {
 		mockMsg.EXPECT().Ack().Return(assert.AnError)
+		mockMetrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_commit_failure_count", "topic", "test.topic")
 		mockMsg.EXPECT().Nak().Return(nil)
 
 		committer.Commit()
@@ -35,6 +37,7 @@ func TestNATSCommitter_Commit(t *testing.T) {
 
 	t.Run("Failed Commit with Failed Nak", func(_ *testing.T) {
 		mockMsg.EXPECT().Ack().Return(assert.AnError)
+		mockMetrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_commit_failure_count", "topic", "test.topic")
 		mockMsg.EXPECT().Nak().Return(assert.AnError)
 
 		committer.Commit()
@@ -46,7 +49,7 @@ func TestNATSCommitter_Nak(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockMsg := NewMockMsg(ctrl)
-	committer := createTestCommitter(mockMsg)
+	committer := createTestCommitter(mockMsg, nil)
 
 	t.Run("Successful Nak", func(t *testing.T) {
 		mockMsg.EXPECT().Nak().Return(nil)
@@ -68,7 +71,7 @@ func TestNATSCommitter_Rollback(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockMsg := NewMockMsg(ctrl)
-	committer := createTestCommitter(mockMsg)
+	committer := createTestCommitter(mockMsg, nil)
 
 	t.Run("Successful Rollback", func(t *testing.T) {
 		mockMsg.EXPECT().Nak().Return(nil)
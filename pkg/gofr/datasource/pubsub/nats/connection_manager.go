@@ -112,6 +112,13 @@ func (cm *ConnectionManager) Close(_ context.Context) {
 }
 
 func (cm *ConnectionManager) Publish(ctx context.Context, subject string, message []byte, metrics Metrics) error {
+	return cm.PublishWithOptions(ctx, subject, message, pubsub.PublishOptions{}, metrics)
+}
+
+// PublishWithOptions publishes the message with the headers of the options and the trace context as the NATS headers,
+// the key being sent in the Gofr-Message-Key header as NATS has no message keys.
+func (cm *ConnectionManager) PublishWithOptions(ctx context.Context, subject string, message []byte,
+	options pubsub.PublishOptions, metrics Metrics) error {
 	metrics.IncrementCounter(ctx, "app_pubsub_publish_total_count", "subject", subject)
 
 	if !cm.isConnected() {
@@ -122,7 +129,16 @@ func (cm *ConnectionManager) Publish(ctx context.Context, subject string, messag
 		return err
 	}
 
-	_, err := cm.jStream.Publish(ctx, subject, message)
+	options.Headers = pubsub.InjectTraceContext(ctx, options.Headers)
+
+	var err error
+
+	if options.IsEmpty() {
+		_, err = cm.jStream.Publish(ctx, subject, message)
+	} else {
+		_, err = cm.jStream.PublishMsg(ctx, &nats.Msg{Subject: subject, Data: message, Header: natsHeader(options)})
+	}
+
 	if err != nil {
 		cm.logger.Errorf("failed to publish message to NATS jStream: %v", err)
 		return err
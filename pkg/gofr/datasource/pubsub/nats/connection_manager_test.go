@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"gofr.dev/pkg/gofr/datasource"
+	"gofr.dev/pkg/gofr/datasource/pubsub"
 	"gofr.dev/pkg/gofr/logging"
 )
 
@@ -103,6 +104,37 @@ func TestConnectionManager_Publish(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestConnectionManager_PublishWithOptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockJS := NewMockJetStream(ctrl)
+	mockMetrics := NewMockMetrics(ctrl)
+	mockConn := NewMockConnInterface(ctrl)
+
+	cm := &ConnectionManager{
+		conn:    mockConn,
+		jStream: mockJS,
+		logger:  logging.NewMockLogger(logging.DEBUG),
+	}
+
+	ctx := t.Context()
+	subject := "test.subject"
+	message := []byte("test message")
+	options := pubsub.PublishOptions{Headers: map[string]string{"tenant": "gofr"}, Key: "order-1"}
+
+	gomock.InOrder(
+		mockMetrics.EXPECT().IncrementCounter(ctx, "app_pubsub_publish_total_count", "subject", subject),
+		mockConn.EXPECT().Status().Return(nats.CONNECTED),
+		mockJS.EXPECT().PublishMsg(ctx, &nats.Msg{Subject: subject, Data: message, Header: natsHeader(options)}).
+			Return(&jetstream.PubAck{}, nil),
+		mockMetrics.EXPECT().IncrementCounter(ctx, "app_pubsub_publish_success_count", "subject", subject),
+	)
+
+	err := cm.PublishWithOptions(ctx, subject, message, options, mockMetrics)
+	require.NoError(t, err)
+}
+
 func TestConnectionManager_validateJetStream(t *testing.T) {
 	cm := &ConnectionManager{
 		jStream: NewMockJetStream(gomock.NewController(t)),
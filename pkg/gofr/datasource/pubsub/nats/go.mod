@@ -8,7 +8,7 @@ require (
 	github.com/stretchr/testify v1.11.1
 	go.opentelemetry.io/otel/trace v1.38.0
 	go.uber.org/mock v0.6.0
-	gofr.dev v1.47.0
+	gofr.dev v1.47.0 // bump to the first release with pubsub.PublishOptions before tagging
 )
 
 require (
@@ -32,6 +32,7 @@ type JetStreamCreator interface {
 // JetStreamClient represents the main Client jStream Client.
 type JetStreamClient interface {
 	Publish(ctx context.Context, subject string, message []byte) error
+	PublishWithOptions(ctx context.Context, subject string, message []byte, options pubsub.PublishOptions) error
 	Subscribe(ctx context.Context, subject string, handler messageHandler) error
 	Close(ctx context.Context) error
 	DeleteStream(ctx context.Context, name string) error
@@ -45,6 +46,7 @@ type ConnectionManagerInterface interface {
 	Connect() error
 	Close(ctx context.Context)
 	Publish(ctx context.Context, subject string, message []byte, metrics Metrics) error
+	PublishWithOptions(ctx context.Context, subject string, message []byte, options pubsub.PublishOptions, metrics Metrics) error
 	Health() datasource.Health
 	jetStream() (jetstream.JetStream, error)
 	isConnected() bool
@@ -1,10 +1,14 @@
 package nats
 
 import (
+	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
 	"gofr.dev/pkg/gofr/datasource/pubsub"
 )
 
+// messageKeyHeader carries the key of a message, as NATS has no message keys.
+const messageKeyHeader = "Gofr-Message-Key"
+
 type natsMessage struct {
 	msg    jetstream.Msg
 	logger pubsub.Logger
@@ -22,3 +26,37 @@ func (nmsg *natsMessage) Commit() {
 		nmsg.logger.Errorf("unable to acknowledge message on Client jStream: %v", err)
 	}
 }
+
+// natsHeader returns the NATS headers for the headers, key and content type of the options.
+func natsHeader(options pubsub.PublishOptions) nats.Header {
+	header := nats.Header{}
+
+	for k, v := range options.AllHeaders() {
+		header.Set(k, v)
+	}
+
+	if options.Key != "" {
+		header.Set(messageKeyHeader, options.Key)
+	}
+
+	return header
+}
+
+// messageHeaders returns the first value of each NATS header, other than the key, and the key of the message.
+func messageHeaders(header nats.Header) (headers map[string]string, key string) {
+	if len(header) == 0 {
+		return nil, ""
+	}
+
+	headers = make(map[string]string, len(header))
+
+	for k := range header {
+		if k == messageKeyHeader {
+			continue
+		}
+
+		headers[k] = header.Get(k)
+	}
+
+	return headers, header.Get(messageKeyHeader)
+}
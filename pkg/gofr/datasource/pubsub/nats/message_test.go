@@ -3,8 +3,10 @@ package nats
 import (
 	"testing"
 
+	"github.com/nats-io/nats.go"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr/datasource/pubsub"
 	"gofr.dev/pkg/gofr/logging"
 	"gofr.dev/pkg/gofr/testutil"
 )
@@ -52,3 +54,27 @@ func TestNATSMessage_CommitError(t *testing.T) {
 
 	assert.Contains(t, out, "unable to acknowledge message on Client jStream")
 }
+
+func TestMessageHeaders(t *testing.T) {
+	header := natsHeader(pubsub.PublishOptions{
+		Headers:     map[string]string{"tenant": "gofr"},
+		Key:         "order-1",
+		ContentType: "application/json",
+	})
+
+	assert.Equal(t, nats.Header{
+		"tenant":         {"gofr"},
+		"content-type":   {"application/json"},
+		messageKeyHeader: {"order-1"},
+	}, header)
+
+	headers, key := messageHeaders(header)
+
+	assert.Equal(t, map[string]string{"tenant": "gofr", "content-type": "application/json"}, headers)
+	assert.Equal(t, "order-1", key)
+
+	headers, key = messageHeaders(nil)
+
+	assert.Nil(t, headers)
+	assert.Empty(t, key)
+}
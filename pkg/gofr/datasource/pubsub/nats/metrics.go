@@ -7,4 +7,5 @@ import "context"
 // Metrics represents the metrics interface.
 type Metrics interface {
 	IncrementCounter(ctx context.Context, name string, labels ...string)
+	SetGauge(name string, value float64, labels ...string)
 }
@@ -287,6 +287,20 @@ func (mr *MockJetStreamClientMockRecorder) Publish(ctx, subject, message any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockJetStreamClient)(nil).Publish), ctx, subject, message)
 }
 
+// PublishWithOptions mocks base method.
+func (m *MockJetStreamClient) PublishWithOptions(ctx context.Context, subject string, message []byte, options pubsub.PublishOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishWithOptions", ctx, subject, message, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishWithOptions indicates an expected call of PublishWithOptions.
+func (mr *MockJetStreamClientMockRecorder) PublishWithOptions(ctx, subject, message, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishWithOptions", reflect.TypeOf((*MockJetStreamClient)(nil).PublishWithOptions), ctx, subject, message, options)
+}
+
 // Subscribe mocks base method.
 func (m *MockJetStreamClient) Subscribe(ctx context.Context, subject string, handler messageHandler) error {
 	m.ctrl.T.Helper()
@@ -392,6 +406,20 @@ func (mr *MockConnectionManagerInterfaceMockRecorder) Publish(ctx, subject, mess
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockConnectionManagerInterface)(nil).Publish), ctx, subject, message, metrics)
 }
 
+// PublishWithOptions mocks base method.
+func (m *MockConnectionManagerInterface) PublishWithOptions(ctx context.Context, subject string, message []byte, options pubsub.PublishOptions, metrics Metrics) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishWithOptions", ctx, subject, message, options, metrics)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishWithOptions indicates an expected call of PublishWithOptions.
+func (mr *MockConnectionManagerInterfaceMockRecorder) PublishWithOptions(ctx, subject, message, options, metrics any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishWithOptions", reflect.TypeOf((*MockConnectionManagerInterface)(nil).PublishWithOptions), ctx, subject, message, options, metrics)
+}
+
 // jetStream mocks base method.
 func (m *MockConnectionManagerInterface) JetStream() (jetstream.JetStream, error) {
 	m.ctrl.T.Helper()
@@ -21,6 +21,11 @@ func (w *PubSubWrapper) Publish(ctx context.Context, topic string, message []byt
 	return w.Client.Publish(ctx, topic, message)
 }
 
+// PublishWithOptions publishes a message to a topic with the headers, key and content type of the options.
+func (w *PubSubWrapper) PublishWithOptions(ctx context.Context, topic string, message []byte, options pubsub.PublishOptions) error {
+	return w.Client.PublishWithOptions(ctx, topic, message, options)
+}
+
 // Subscribe subscribes to a topic and returns a single message.
 func (w *PubSubWrapper) Subscribe(ctx context.Context, topic string) (*pubsub.Message, error) {
 	return w.Client.Subscribe(ctx, topic)
@@ -63,7 +63,7 @@ func (sm *SubscriptionManager) Subscribe(
 		sm.subscriptions[topic] = &subscription{cancel: cancel}
 
 		buffer := sm.getOrCreateBuffer(topic)
-		go sm.consumeMessages(subCtx, cons, topic, buffer, cfg, logger)
+		go sm.consumeMessages(subCtx, cons, topic, buffer, cfg, logger, metrics)
 	}
 
 	sm.subMutex.Unlock()
@@ -126,14 +126,15 @@ func (sm *SubscriptionManager) consumeMessages(
 	topic string,
 	buffer chan *pubsub.Message,
 	cfg *Config,
-	logger pubsub.Logger) {
+	logger pubsub.Logger,
+	metrics Metrics) {
 	// TODO: propagate errors to caller
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		default:
-			if err := sm.fetchAndProcessMessages(ctx, cons, topic, buffer, cfg, logger); err != nil {
+			if err := sm.fetchAndProcessMessages(ctx, cons, topic, buffer, cfg, logger, metrics); err != nil {
 				logger.Errorf("Error fetching messages for topic %s: %v", topic, err)
 			}
 		}
@@ -146,13 +147,14 @@ func (sm *SubscriptionManager) fetchAndProcessMessages(
 	topic string,
 	buffer chan *pubsub.Message,
 	cfg *Config,
-	logger pubsub.Logger) error {
+	logger pubsub.Logger,
+	metrics Metrics) error {
 	msgs, err := cons.Fetch(1, jetstream.FetchMaxWait(cfg.MaxWait))
 	if err != nil {
 		return sm.handleFetchError(err, topic, logger)
 	}
 
-	return sm.processFetchedMessages(msgs, topic, buffer, logger)
+	return sm.processFetchedMessages(msgs, topic, buffer, logger, metrics)
 }
 
 func (*SubscriptionManager) handleFetchError(err error, topic string, logger pubsub.Logger) error {
@@ -169,9 +171,12 @@ func (sm *SubscriptionManager) processFetchedMessages(
 	msgs jetstream.MessageBatch,
 	topic string,
 	buffer chan *pubsub.Message,
-	logger pubsub.Logger) error {
+	logger pubsub.Logger,
+	metrics Metrics) error {
 	for msg := range msgs.Messages() {
-		pubsubMsg := sm.createPubSubMessage(msg, topic)
+		pubsubMsg := sm.createPubSubMessage(msg, topic, metrics)
+
+		setConsumerLag(msg, topic, metrics)
 
 		if !sm.sendToBuffer(pubsubMsg, buffer) {
 			logger.Logf("Message buffer is full for topic %s. Consider increasing buffer size or processing messages faster.", topic)
@@ -181,16 +186,31 @@ func (sm *SubscriptionManager) processFetchedMessages(
 	return sm.checkBatchError(msgs, topic, logger)
 }
 
-func (*SubscriptionManager) createPubSubMessage(msg jetstream.Msg, topic string) *pubsub.Message {
+func (*SubscriptionManager) createPubSubMessage(msg jetstream.Msg, topic string, metrics Metrics) *pubsub.Message {
 	pubsubMsg := pubsub.NewMessage(context.Background()) // Pass a context if needed
 	pubsubMsg.Topic = topic
 	pubsubMsg.Value = msg.Data()
 	pubsubMsg.MetaData = msg.Headers()
-	pubsubMsg.Committer = &natsCommitter{msg: msg}
+	headers, key := messageHeaders(msg.Headers())
+	pubsubMsg.SetHeaders(headers)
+	pubsubMsg.Key = key
+	pubsubMsg.Committer = &natsCommitter{msg: msg, topic: topic, metrics: metrics}
 
 	return pubsubMsg
 }
 
+// setConsumerLag sets the lag of the consumer of the message, the number of the messages of the topic pending
+// for the consumer.
+func setConsumerLag(msg jetstream.Msg, topic string, metrics Metrics) {
+	metadata, err := msg.Metadata()
+	if err != nil {
+		return
+	}
+
+	metrics.SetGauge("app_pubsub_consumer_lag", float64(metadata.NumPending), "topic", topic,
+		"consumer_group", metadata.Consumer)
+}
+
 func (*SubscriptionManager) sendToBuffer(msg *pubsub.Message, buffer chan *pubsub.Message) bool {
 	select {
 	case buffer <- msg:
@@ -49,6 +49,7 @@ func TestSubscriptionManager_Subscribe(t *testing.T) {
 	mockMetrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_subscribe_total_count", "topic", topic)
 	mockConsumer.EXPECT().Fetch(gomock.Any(), gomock.Any()).Return(createMockMessageBatch(ctrl), nil).AnyTimes()
 	mockMetrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_subscribe_success_count", "topic", topic)
+	mockMetrics.EXPECT().SetGauge("app_pubsub_consumer_lag", 4.0, "topic", topic, "consumer_group", "consumer").AnyTimes()
 
 	msg, err := sm.Subscribe(ctx, topic, mockJS, cfg, mockLogger, mockMetrics)
 	require.NoError(t, err)
@@ -146,6 +147,7 @@ func TestSubscriptionManager_consumeMessages(t *testing.T) {
 
 	mockConsumer := NewMockConsumer(ctrl)
 	mockLogger := logging.NewMockLogger(logging.DEBUG)
+	mockMetrics := NewMockMetrics(ctrl)
 
 	sm := newSubscriptionManager(1)
 	cfg := &Config{MaxWait: time.Second}
@@ -157,8 +159,9 @@ func TestSubscriptionManager_consumeMessages(t *testing.T) {
 
 	mockBatch := createMockMessageBatch(ctrl)
 	mockConsumer.EXPECT().Fetch(gomock.Any(), gomock.Any()).Return(mockBatch, nil).AnyTimes()
+	mockMetrics.EXPECT().SetGauge("app_pubsub_consumer_lag", 4.0, "topic", topic, "consumer_group", "consumer").MinTimes(1)
 
-	go sm.consumeMessages(ctx, mockConsumer, topic, buffer, cfg, mockLogger)
+	go sm.consumeMessages(ctx, mockConsumer, topic, buffer, cfg, mockLogger, mockMetrics)
 
 	select {
 	case msg := <-buffer:
@@ -175,6 +178,7 @@ func createMockMessageBatch(ctrl *gomock.Controller) jetstream.MessageBatch {
 
 	mockMsg.EXPECT().Data().Return([]byte("test message")).AnyTimes()
 	mockMsg.EXPECT().Headers().Return(nil).AnyTimes()
+	mockMsg.EXPECT().Metadata().Return(&jetstream.MsgMetadata{NumPending: 4, Consumer: "consumer"}, nil).AnyTimes()
 
 	msgChan := make(chan jetstream.Msg, 1)
 	msgChan <- mockMsg
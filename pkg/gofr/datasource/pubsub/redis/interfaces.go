@@ -0,0 +1,19 @@
+package redis
+
+import (
+	"context"
+
+	goRedis "github.com/redis/go-redis/v9"
+)
+
+// Redis is the client of the Redis server holding the streams, e.g. the Redis datasource of the container.
+type Redis interface {
+	XAdd(ctx context.Context, a *goRedis.XAddArgs) *goRedis.StringCmd
+	XReadGroup(ctx context.Context, a *goRedis.XReadGroupArgs) *goRedis.XStreamSliceCmd
+	XAck(ctx context.Context, stream, group string, ids ...string) *goRedis.IntCmd
+	XAutoClaim(ctx context.Context, a *goRedis.XAutoClaimArgs) *goRedis.XAutoClaimCmd
+	XGroupCreateMkStream(ctx context.Context, stream, group, start string) *goRedis.StatusCmd
+	XRangeN(ctx context.Context, stream, start, stop string, count int64) *goRedis.XMessageSliceCmd
+	Del(ctx context.Context, keys ...string) *goRedis.IntCmd
+	Ping(ctx context.Context) *goRedis.StatusCmd
+}
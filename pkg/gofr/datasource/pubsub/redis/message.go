@@ -0,0 +1,23 @@
+package redis
+
+import (
+	"context"
+
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+)
+
+// committer acknowledges a message of a stream for the consumer group, removing it from the pending messages
+// of the group.
+type committer struct {
+	client Redis
+	stream string
+	group  string
+	id     string
+	logger pubsub.Logger
+}
+
+func (c *committer) Commit() {
+	if err := c.client.XAck(context.Background(), c.stream, c.group, c.id).Err(); err != nil {
+		c.logger.Errorf("failed to acknowledge message %s of redis stream %s: %v", c.id, c.stream, err)
+	}
+}
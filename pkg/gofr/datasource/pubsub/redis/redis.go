@@ -0,0 +1,502 @@
+// Package redis provides a pub/sub client on Redis Streams. The topics are streams, read by consumer groups with
+// XREADGROUP and committed with XACK, while the messages left pending by crashed consumers are reclaimed with XAUTOCLAIM.
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	goRedis "github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+
+	"gofr.dev/pkg/gofr/datasource"
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+)
+
+const (
+	// DefaultConsumerGroup is the consumer group of the clients created without one.
+	DefaultConsumerGroup = "gofr"
+
+	defaultBlockTimeout = 5 * time.Second
+	defaultClaimMinIdle = time.Minute
+	defaultQueryLimit   = 10
+	claimCount          = 10
+	healthTimeout       = 5 * time.Second
+
+	fieldPayload = "payload"
+	fieldKey     = "key"
+	fieldHeaders = "headers"
+)
+
+var (
+	errEmptyTopicName     = errors.New("topic name cannot be empty")
+	errRedisNotConfigured = errors.New("redis is not configured for the pubsub")
+)
+
+// Config is the configuration of the Redis Streams pub/sub client.
+type Config struct {
+	// ConsumerGroupID is the consumer group reading the streams. It defaults to DefaultConsumerGroup.
+	// A consumer group created by the client reads the messages of the stream from its first message.
+	ConsumerGroupID string
+	// ConsumerName identifies the consumer in the consumer group. It defaults to the host name.
+	ConsumerName string
+	// MaxLen is the approximate number of messages retained in each stream, the oldest messages being trimmed
+	// as the messages are published. The streams are not trimmed when it is 0.
+	MaxLen int64
+	// BlockTimeout is the time for which a read waits for a message, before it is retried. It defaults to 5 seconds.
+	BlockTimeout time.Duration
+	// ClaimMinIdle is the time after which a message read and not committed by a consumer, e.g. one which crashed,
+	// is reclaimed by the other consumers of the group. It defaults to 1 minute.
+	ClaimMinIdle time.Duration
+}
+
+// Client is the pub/sub client on Redis Streams.
+type Client struct {
+	client  Redis
+	config  Config
+	logger  pubsub.Logger
+	metrics Metrics
+
+	mu     sync.Mutex
+	topics map[string]*topicState
+}
+
+// topicState is the state of the reading of a stream by the client.
+type topicState struct {
+	// claimed are the messages reclaimed from the other consumers, which are yet to be returned.
+	claimed []goRedis.XMessage
+	// claimStart is the ID from which the next XAUTOCLAIM scans the pending messages.
+	claimStart string
+	lastClaim  time.Time
+}
+
+// New returns a pub/sub client on the streams of the Redis client, with the defaults set for the missing values
+// of the config.
+func New(client Redis, conf Config, logger pubsub.Logger, metrics Metrics) *Client {
+	if conf.ConsumerGroupID == "" {
+		conf.ConsumerGroupID = DefaultConsumerGroup
+	}
+
+	if conf.ConsumerName == "" {
+		conf.ConsumerName, _ = os.Hostname()
+	}
+
+	if conf.BlockTimeout <= 0 {
+		conf.BlockTimeout = defaultBlockTimeout
+	}
+
+	if conf.ClaimMinIdle <= 0 {
+		conf.ClaimMinIdle = defaultClaimMinIdle
+	}
+
+	logger.Debugf("using redis streams pubsub with consumer group '%s' and consumer '%s'",
+		conf.ConsumerGroupID, conf.ConsumerName)
+
+	return &Client{
+		client:  client,
+		config:  conf,
+		logger:  logger,
+		metrics: metrics,
+		topics:  make(map[string]*topicState),
+	}
+}
+
+func (c *Client) Publish(ctx context.Context, topic string, message []byte) error {
+	return c.PublishWithOptions(ctx, topic, message, pubsub.PublishOptions{})
+}
+
+// PublishWithOptions adds the message to the stream of the topic, with the key and the headers of the options and
+// the trace context as the fields of the stream entry. The stream is trimmed to MaxLen, when set.
+func (c *Client) PublishWithOptions(ctx context.Context, topic string, message []byte, options pubsub.PublishOptions) error {
+	ctx, span := otel.GetTracerProvider().Tracer("gofr").Start(ctx, "redis-publish")
+	defer span.End()
+
+	c.metrics.IncrementCounter(ctx, "app_pubsub_publish_total_count", "topic", topic)
+
+	if topic == "" {
+		return errEmptyTopicName
+	}
+
+	if c.client == nil {
+		return errRedisNotConfigured
+	}
+
+	values := map[string]any{fieldPayload: message}
+
+	if options.Key != "" {
+		values[fieldKey] = options.Key
+	}
+
+	if headers := pubsub.InjectTraceContext(ctx, options.AllHeaders()); len(headers) > 0 {
+		b, err := json.Marshal(headers)
+		if err != nil {
+			return err
+		}
+
+		values[fieldHeaders] = string(b)
+	}
+
+	start := time.Now()
+
+	err := c.client.XAdd(ctx, &goRedis.XAddArgs{
+		Stream: topic,
+		MaxLen: c.config.MaxLen,
+		Approx: c.config.MaxLen > 0,
+		Values: values,
+	}).Err()
+	if err != nil {
+		c.logger.Errorf("failed to publish message to redis stream %s, error: %v", topic, err)
+
+		return err
+	}
+
+	c.logger.Debug(&pubsub.Log{
+		Mode:          "PUB",
+		CorrelationID: span.SpanContext().TraceID().String(),
+		MessageValue:  string(message),
+		Topic:         topic,
+		Host:          "redis",
+		PubSubBackend: "REDIS",
+		Time:          time.Since(start).Microseconds(),
+	})
+
+	c.metrics.IncrementCounter(ctx, "app_pubsub_publish_success_count", "topic", topic)
+
+	return nil
+}
+
+// Subscribe returns the next message of the stream of the topic for the consumer group, waiting for it until ctx
+// is done. The messages pending with the other consumers of the group for longer than ClaimMinIdle are returned
+// before the new messages.
+func (c *Client) Subscribe(ctx context.Context, topic string) (*pubsub.Message, error) {
+	if topic == "" {
+		return nil, errEmptyTopicName
+	}
+
+	if c.client == nil {
+		return nil, errRedisNotConfigured
+	}
+
+	if err := c.createGroup(ctx, topic); err != nil {
+		c.logger.Errorf("failed to create consumer group %s for redis stream %s: %v", c.config.ConsumerGroupID, topic, err)
+
+		return nil, err
+	}
+
+	ctx, span := otel.GetTracerProvider().Tracer("gofr").Start(ctx, "redis-subscribe")
+	defer span.End()
+
+	c.metrics.IncrementCounter(ctx, "app_pubsub_subscribe_total_count", "topic", topic,
+		"consumer_group", c.config.ConsumerGroupID)
+
+	start := time.Now()
+
+	var entry *goRedis.XMessage
+
+	for entry == nil {
+		var err error
+
+		entry, err = c.read(ctx, topic)
+		if err != nil {
+			c.forgetGroup(topic, err)
+
+			if ctx.Err() == nil {
+				c.logger.Errorf("failed to read message from redis stream %s: %v", topic, err)
+			}
+
+			return nil, err
+		}
+	}
+
+	msg := pubsub.NewMessage(ctx)
+	msg.Topic = topic
+	msg.Value = []byte(stringValue(entry.Values[fieldPayload]))
+	msg.Key = stringValue(entry.Values[fieldKey])
+	msg.SetHeaders(headers(entry.Values[fieldHeaders]))
+	msg.Committer = &committer{client: c.client, stream: topic, group: c.config.ConsumerGroupID, id: entry.ID,
+		logger: c.logger}
+
+	c.logger.Debug(&pubsub.Log{
+		Mode:          "SUB",
+		CorrelationID: span.SpanContext().TraceID().String(),
+		MessageValue:  string(msg.Value),
+		Topic:         topic,
+		Host:          "redis",
+		PubSubBackend: "REDIS",
+		Time:          time.Since(start).Microseconds(),
+	})
+
+	c.metrics.IncrementCounter(ctx, "app_pubsub_subscribe_success_count", "topic", topic,
+		"consumer_group", c.config.ConsumerGroupID)
+
+	return msg, nil
+}
+
+// read returns a reclaimed or a new message of the stream, or nil when no message is read in BlockTimeout.
+func (c *Client) read(ctx context.Context, topic string) (*goRedis.XMessage, error) {
+	if entry := c.claim(ctx, topic); entry != nil {
+		return entry, nil
+	}
+
+	streams, err := c.client.XReadGroup(ctx, &goRedis.XReadGroupArgs{
+		Group:    c.config.ConsumerGroupID,
+		Consumer: c.config.ConsumerName,
+		Streams:  []string{topic, ">"},
+		Count:    1,
+		Block:    c.config.BlockTimeout,
+	}).Result()
+
+	switch {
+	case errors.Is(err, goRedis.Nil):
+		return nil, ctx.Err()
+	case err != nil:
+		return nil, err
+	case len(streams) == 0 || len(streams[0].Messages) == 0:
+		return nil, ctx.Err()
+	}
+
+	return &streams[0].Messages[0], nil
+}
+
+// claim returns a message pending with another consumer for longer than ClaimMinIdle, reclaiming the pending
+// messages of the stream at most once every ClaimMinIdle. The lock is not held while reclaiming, so that the
+// subscriptions of the other topics are not blocked by it.
+func (c *Client) claim(ctx context.Context, topic string) *goRedis.XMessage {
+	c.mu.Lock()
+
+	state := c.topic(topic)
+
+	if len(state.claimed) == 0 && time.Since(state.lastClaim) >= c.config.ClaimMinIdle {
+		// lastClaim is set before reclaiming, so that the concurrent subscriptions of the topic do not reclaim too.
+		state.lastClaim = time.Now()
+		start := state.claimStart
+
+		c.mu.Unlock()
+
+		messages, next, err := c.client.XAutoClaim(ctx, &goRedis.XAutoClaimArgs{
+			Stream:   topic,
+			Group:    c.config.ConsumerGroupID,
+			Consumer: c.config.ConsumerName,
+			MinIdle:  c.config.ClaimMinIdle,
+			Start:    start,
+			Count:    claimCount,
+		}).Result()
+		if err != nil {
+			c.forgetGroup(topic, err)
+			c.logger.Errorf("failed to reclaim pending messages of redis stream %s: %v", topic, err)
+
+			return nil
+		}
+
+		if len(messages) > 0 {
+			c.logger.Debugf("reclaimed %d pending messages of redis stream %s", len(messages), topic)
+		}
+
+		c.mu.Lock()
+
+		state.claimStart = next
+		state.claimed = append(state.claimed, messages...)
+	}
+
+	defer c.mu.Unlock()
+
+	if len(state.claimed) == 0 {
+		return nil
+	}
+
+	entry := state.claimed[0]
+	state.claimed = state.claimed[1:]
+
+	return &entry
+}
+
+// createGroup creates the consumer group of the stream, and the stream, if they do not exist.
+func (c *Client) createGroup(ctx context.Context, topic string) error {
+	c.mu.Lock()
+	_, ok := c.topics[topic]
+	c.mu.Unlock()
+
+	if ok {
+		return nil
+	}
+
+	err := c.client.XGroupCreateMkStream(ctx, topic, c.config.ConsumerGroupID, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return err
+	}
+
+	c.mu.Lock()
+	c.topic(topic)
+	c.mu.Unlock()
+
+	return nil
+}
+
+// forgetGroup forgets the state of the stream when the error is NOGROUP, i.e. the stream or its consumer group was
+// deleted, e.g. by another client, so that createGroup creates them again in the next subscription.
+func (c *Client) forgetGroup(topic string, err error) {
+	if !strings.HasPrefix(err.Error(), "NOGROUP") {
+		return
+	}
+
+	c.mu.Lock()
+	delete(c.topics, topic)
+	c.mu.Unlock()
+}
+
+// topic returns the state of the stream, creating it if it does not exist. It is called holding the lock.
+func (c *Client) topic(name string) *topicState {
+	state, ok := c.topics[name]
+	if !ok {
+		state = &topicState{claimStart: "0-0"}
+		c.topics[name] = state
+	}
+
+	return state
+}
+
+// Query returns the payloads of the messages of the stream, separated by new lines. The args are the ID of the first
+// message as string, "-" by default for the first message of the stream, and the maximum number of messages as int,
+// 10 by default.
+func (c *Client) Query(ctx context.Context, query string, args ...any) ([]byte, error) {
+	if query == "" {
+		return nil, errEmptyTopicName
+	}
+
+	if c.client == nil {
+		return nil, errRedisNotConfigured
+	}
+
+	start, limit := "-", defaultQueryLimit
+
+	if len(args) > 0 {
+		if val, ok := args[0].(string); ok {
+			start = val
+		}
+	}
+
+	if len(args) > 1 {
+		if val, ok := args[1].(int); ok {
+			limit = val
+		}
+	}
+
+	entries, err := c.client.XRangeN(ctx, query, start, "+", int64(limit)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []byte
+
+	for _, entry := range entries {
+		if len(result) > 0 {
+			result = append(result, '\n')
+		}
+
+		result = append(result, stringValue(entry.Values[fieldPayload])...)
+	}
+
+	return result, nil
+}
+
+// CreateTopic creates the stream of the topic, with the consumer group of the client.
+func (c *Client) CreateTopic(ctx context.Context, name string) error {
+	if name == "" {
+		return errEmptyTopicName
+	}
+
+	if c.client == nil {
+		return errRedisNotConfigured
+	}
+
+	return c.createGroup(ctx, name)
+}
+
+// DeleteTopic deletes the stream of the topic, with its messages and consumer groups.
+func (c *Client) DeleteTopic(ctx context.Context, name string) error {
+	if name == "" {
+		return errEmptyTopicName
+	}
+
+	if c.client == nil {
+		return errRedisNotConfigured
+	}
+
+	if err := c.client.Del(ctx, name).Err(); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	delete(c.topics, name)
+	c.mu.Unlock()
+
+	return nil
+}
+
+func (c *Client) Health() datasource.Health {
+	res := datasource.Health{
+		Status: datasource.StatusDown,
+		Details: map[string]any{
+			"backend":        "REDIS",
+			"consumer_group": c.config.ConsumerGroupID,
+			"consumer":       c.config.ConsumerName,
+		},
+	}
+
+	if c.client == nil {
+		c.logger.Errorf("%v", "datasource not initialized")
+
+		return res
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), healthTimeout)
+	defer cancel()
+
+	if err := c.client.Ping(ctx).Err(); err != nil {
+		c.logger.Errorf("health check failed: %v", err)
+
+		res.Details["error"] = err.Error()
+
+		return res
+	}
+
+	res.Status = datasource.StatusUp
+
+	return res
+}
+
+// Close does not close the Redis client, which is closed by its owner, e.g. the container.
+func (*Client) Close() error {
+	return nil
+}
+
+func stringValue(v any) string {
+	switch val := v.(type) {
+	case string:
+		return val
+	case []byte:
+		return string(val)
+	default:
+		return ""
+	}
+}
+
+func headers(v any) map[string]string {
+	s := stringValue(v)
+	if s == "" {
+		return nil
+	}
+
+	var h map[string]string
+
+	if err := json.Unmarshal([]byte(s), &h); err != nil {
+		return nil
+	}
+
+	return h
+}
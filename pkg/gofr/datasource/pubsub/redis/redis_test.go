@@ -0,0 +1,256 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	goRedis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"gofr.dev/pkg/gofr/datasource"
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+	"gofr.dev/pkg/gofr/logging"
+)
+
+func newTestRedis(t *testing.T) (*miniredis.Miniredis, *goRedis.Client) {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+
+	client := goRedis.NewClient(&goRedis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	return mr, client
+}
+
+func newTestClient(t *testing.T, client Redis, conf Config) *Client {
+	t.Helper()
+
+	metrics := NewMockMetrics(gomock.NewController(t))
+	metrics.EXPECT().IncrementCounter(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	if conf.BlockTimeout == 0 {
+		conf.BlockTimeout = 10 * time.Millisecond
+	}
+
+	return New(client, conf, logging.NewMockLogger(logging.ERROR), metrics)
+}
+
+func TestClient_PublishSubscribe(t *testing.T) {
+	_, rc := newTestRedis(t)
+	client := newTestClient(t, rc, Config{ConsumerName: "consumer-1"})
+
+	err := client.PublishWithOptions(t.Context(), "orders", []byte(`{"id":1}`), pubsub.PublishOptions{
+		Headers:     map[string]string{"tenant": "gofr"},
+		Key:         "order-1",
+		ContentType: "application/json",
+	})
+	require.NoError(t, err)
+
+	msg, err := client.Subscribe(t.Context(), "orders")
+	require.NoError(t, err)
+
+	assert.Equal(t, "orders", msg.Topic)
+	assert.Equal(t, []byte(`{"id":1}`), msg.Value)
+	assert.Equal(t, "order-1", msg.Key)
+	assert.Equal(t, "gofr", msg.Header("tenant"))
+	assert.Equal(t, "application/json", msg.ContentType())
+	assert.Equal(t, DefaultConsumerGroup, client.config.ConsumerGroupID)
+
+	pending, err := rc.XPending(t.Context(), "orders", DefaultConsumerGroup).Result()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), pending.Count)
+
+	msg.Commit()
+
+	pending, err = rc.XPending(t.Context(), "orders", DefaultConsumerGroup).Result()
+	require.NoError(t, err)
+	assert.Zero(t, pending.Count, "the committed message is still pending")
+}
+
+func TestClient_ConsumerGroups(t *testing.T) {
+	_, rc := newTestRedis(t)
+
+	publisher := newTestClient(t, rc, Config{})
+	first := newTestClient(t, rc, Config{ConsumerGroupID: "first"})
+	second := newTestClient(t, rc, Config{ConsumerGroupID: "second"})
+
+	// the consumer groups are created before the messages are published, and read them from the start of the stream.
+	require.NoError(t, first.CreateTopic(t.Context(), "orders"))
+	require.NoError(t, publisher.Publish(t.Context(), "orders", []byte("1")))
+	require.NoError(t, publisher.Publish(t.Context(), "orders", []byte("2")))
+
+	for _, client := range []*Client{first, second} {
+		for _, value := range []string{"1", "2"} {
+			msg, err := client.Subscribe(t.Context(), "orders")
+			require.NoError(t, err)
+			assert.Equal(t, value, string(msg.Value), "each consumer group does not read every message")
+		}
+	}
+}
+
+func TestClient_SubscribeWaitsForMessage(t *testing.T) {
+	_, rc := newTestRedis(t)
+	client := newTestClient(t, rc, Config{})
+
+	go func() {
+		time.Sleep(30 * time.Millisecond)
+
+		_ = client.Publish(context.Background(), "orders", []byte("1"))
+	}()
+
+	msg, err := client.Subscribe(t.Context(), "orders")
+	require.NoError(t, err)
+	assert.Equal(t, "1", string(msg.Value))
+
+	ctx, cancel := context.WithTimeout(t.Context(), 30*time.Millisecond)
+	defer cancel()
+
+	_, err = client.Subscribe(ctx, "orders")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestClient_ReclaimsPendingMessages(t *testing.T) {
+	_, rc := newTestRedis(t)
+
+	crashed := newTestClient(t, rc, Config{ConsumerName: "crashed", ClaimMinIdle: 20 * time.Millisecond})
+	require.NoError(t, crashed.Publish(t.Context(), "orders", []byte("1")))
+
+	// the message is read and not committed by the crashed consumer.
+	_, err := crashed.Subscribe(t.Context(), "orders")
+	require.NoError(t, err)
+
+	time.Sleep(30 * time.Millisecond)
+
+	consumer := newTestClient(t, rc, Config{ConsumerName: "consumer", ClaimMinIdle: 20 * time.Millisecond})
+
+	msg, err := consumer.Subscribe(t.Context(), "orders")
+	require.NoError(t, err)
+	assert.Equal(t, "1", string(msg.Value), "the pending message is not reclaimed")
+
+	msg.Commit()
+
+	pending, err := rc.XPending(t.Context(), "orders", DefaultConsumerGroup).Result()
+	require.NoError(t, err)
+	assert.Zero(t, pending.Count)
+}
+
+func TestClient_RecreatesDeletedGroup(t *testing.T) {
+	_, rc := newTestRedis(t)
+	client := newTestClient(t, rc, Config{ConsumerName: "consumer"})
+
+	require.NoError(t, client.Publish(t.Context(), "orders", []byte("1")))
+
+	msg, err := client.Subscribe(t.Context(), "orders")
+	require.NoError(t, err)
+	msg.Commit()
+
+	// the stream is deleted with its consumer group by another client.
+	require.NoError(t, rc.Del(t.Context(), "orders").Err())
+
+	_, err = client.Subscribe(t.Context(), "orders")
+	require.ErrorContains(t, err, "NOGROUP")
+
+	require.NoError(t, client.Publish(t.Context(), "orders", []byte("2")))
+
+	msg, err = client.Subscribe(t.Context(), "orders")
+	require.NoError(t, err, "the consumer group is not created again")
+	assert.Equal(t, "2", string(msg.Value))
+}
+
+func TestClient_MaxLen(t *testing.T) {
+	_, rc := newTestRedis(t)
+	client := newTestClient(t, rc, Config{MaxLen: 2})
+
+	for _, value := range []string{"1", "2", "3"} {
+		require.NoError(t, client.Publish(t.Context(), "orders", []byte(value)))
+	}
+
+	result, err := client.Query(t.Context(), "orders")
+	require.NoError(t, err)
+	assert.Equal(t, "2\n3", string(result), "the stream is not trimmed")
+}
+
+func TestClient_Query(t *testing.T) {
+	_, rc := newTestRedis(t)
+	client := newTestClient(t, rc, Config{})
+
+	for _, value := range []string{"1", "2", "3"} {
+		require.NoError(t, client.Publish(t.Context(), "orders", []byte(value)))
+	}
+
+	result, err := client.Query(t.Context(), "orders", "-", 2)
+	require.NoError(t, err)
+	assert.Equal(t, "1\n2", string(result))
+
+	result, err = client.Query(t.Context(), "payments")
+	require.NoError(t, err)
+	assert.Empty(t, result)
+
+	_, err = client.Query(t.Context(), "")
+	require.ErrorIs(t, err, errEmptyTopicName)
+}
+
+func TestClient_Topics(t *testing.T) {
+	_, rc := newTestRedis(t)
+	client := newTestClient(t, rc, Config{})
+
+	require.NoError(t, client.CreateTopic(t.Context(), "orders"))
+	require.NoError(t, client.CreateTopic(t.Context(), "orders"), "creating an existing topic fails")
+
+	exists, err := rc.Exists(t.Context(), "orders").Result()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), exists)
+
+	require.NoError(t, client.DeleteTopic(t.Context(), "orders"))
+
+	exists, err = rc.Exists(t.Context(), "orders").Result()
+	require.NoError(t, err)
+	assert.Zero(t, exists)
+
+	require.ErrorIs(t, client.CreateTopic(t.Context(), ""), errEmptyTopicName)
+	require.ErrorIs(t, client.DeleteTopic(t.Context(), ""), errEmptyTopicName)
+	require.ErrorIs(t, client.Publish(t.Context(), "", []byte("1")), errEmptyTopicName)
+
+	_, err = client.Subscribe(t.Context(), "")
+	require.ErrorIs(t, err, errEmptyTopicName)
+}
+
+func TestClient_NotConfigured(t *testing.T) {
+	client := newTestClient(t, nil, Config{})
+
+	require.ErrorIs(t, client.Publish(t.Context(), "orders", []byte("1")), errRedisNotConfigured)
+	require.ErrorIs(t, client.CreateTopic(t.Context(), "orders"), errRedisNotConfigured)
+	require.ErrorIs(t, client.DeleteTopic(t.Context(), "orders"), errRedisNotConfigured)
+
+	_, err := client.Subscribe(t.Context(), "orders")
+	require.ErrorIs(t, err, errRedisNotConfigured)
+
+	_, err = client.Query(t.Context(), "orders")
+	require.ErrorIs(t, err, errRedisNotConfigured)
+
+	assert.Equal(t, datasource.StatusDown, client.Health().Status)
+}
+
+func TestClient_Health(t *testing.T) {
+	mr, rc := newTestRedis(t)
+	client := newTestClient(t, rc, Config{ConsumerGroupID: "orders-service", ConsumerName: "consumer-1"})
+
+	health := client.Health()
+	assert.Equal(t, datasource.StatusUp, health.Status)
+	assert.Equal(t, "REDIS", health.Details["backend"])
+	assert.Equal(t, "orders-service", health.Details["consumer_group"])
+	assert.Equal(t, "consumer-1", health.Details["consumer"])
+
+	mr.Close()
+
+	health = client.Health()
+	assert.Equal(t, datasource.StatusDown, health.Status)
+	assert.NotEmpty(t, health.Details["error"])
+
+	require.NoError(t, client.Close())
+}
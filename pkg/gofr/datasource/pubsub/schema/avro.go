@@ -0,0 +1,102 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hamba/avro/v2"
+)
+
+// AvroCodec encodes and decodes the Avro messages. A received message is decoded with the schema of the codec,
+// resolved against the schema with which it was encoded, so that the messages of the compatible versions of the
+// schema are decoded into the same Go type.
+type AvroCodec struct {
+	codec
+	schema avro.Schema
+
+	mu sync.RWMutex
+	// readers are the schemas of the codec resolved against the schemas of the received messages.
+	readers map[int]avro.Schema
+}
+
+// NewAvroCodec returns the codec of the Avro messages, with the Avro schema of the config.
+func NewAvroCodec(registry *Registry, config CodecConfig) (*AvroCodec, error) {
+	schema, err := avro.Parse(config.Schema)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSchema, err)
+	}
+
+	return &AvroCodec{codec: newCodec(registry, config, Avro), schema: schema, readers: make(map[int]avro.Schema)}, nil
+}
+
+func (*AvroCodec) ContentType() string {
+	return "application/avro"
+}
+
+// Encode encodes v, e.g. a struct with avro tags, with the schema of the codec.
+func (c *AvroCodec) Encode(ctx context.Context, topic string, v any) ([]byte, error) {
+	value, err := avro.Marshal(c.schema, v)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSchemaValidation, err)
+	}
+
+	id, err := c.id(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeWire(id, value), nil
+}
+
+// Decode decodes the message into v, returning ErrIncompatibleSchema when the schema of the message cannot be
+// resolved against the schema of the codec.
+func (c *AvroCodec) Decode(ctx context.Context, topic string, data []byte, v any) error {
+	id, value, err := decodeWire(data)
+	if err != nil {
+		return err
+	}
+
+	reader, err := c.reader(ctx, id)
+	if err != nil {
+		return fmt.Errorf("message of topic %s: %w", topic, err)
+	}
+
+	return avro.Unmarshal(reader, value, v)
+}
+
+// reader returns the schema of the codec resolved against the schema with the ID.
+func (c *AvroCodec) reader(ctx context.Context, id int) (avro.Schema, error) {
+	c.mu.RLock()
+	reader, ok := c.readers[id]
+	c.mu.RUnlock()
+
+	if ok {
+		return reader, nil
+	}
+
+	schema, err := c.writerSchema(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	writer, err := avro.Parse(schema.Definition)
+	if err != nil {
+		return nil, fmt.Errorf("%w: schema %d: %w", ErrInvalidSchema, id, err)
+	}
+
+	reader = c.schema
+
+	if writer.Fingerprint() != c.schema.Fingerprint() {
+		reader, err = avro.NewSchemaCompatibility().Resolve(c.schema, writer)
+		if err != nil {
+			return nil, fmt.Errorf("%w: schema %d: %w", ErrIncompatibleSchema, id, err)
+		}
+	}
+
+	c.mu.Lock()
+	c.readers[id] = reader
+	c.mu.Unlock()
+
+	return reader, nil
+}
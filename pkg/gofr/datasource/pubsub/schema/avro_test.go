@@ -0,0 +1,100 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+)
+
+const orderSchema = `{"type":"record","name":"Order","fields":[{"name":"id","type":"string"},{"name":"amount","type":"double"}]}`
+
+type order struct {
+	ID     string  `avro:"id" json:"id"`
+	Amount float64 `avro:"amount" json:"amount"`
+}
+
+func TestAvroCodec(t *testing.T) {
+	fake, registry := newFakeRegistry(t)
+
+	codec, err := NewAvroCodec(registry, CodecConfig{Schema: orderSchema, AutoRegister: true})
+	require.NoError(t, err)
+
+	var _ pubsub.Codec = codec
+
+	assert.Equal(t, "application/avro", codec.ContentType())
+
+	data, err := codec.Encode(t.Context(), "orders", order{ID: "order-1", Amount: 9.5})
+	require.NoError(t, err)
+
+	id, ok := fake.lookup("orders-value", orderSchema)
+	require.True(t, ok, "the schema is not registered under the subject of the topic")
+
+	var o order
+
+	require.NoError(t, codec.Decode(t.Context(), "orders", data, &o))
+	assert.Equal(t, order{ID: "order-1", Amount: 9.5}, o)
+
+	gotID, _, err := decodeWire(data)
+	require.NoError(t, err)
+	assert.Equal(t, id, gotID)
+}
+
+func TestAvroCodec_SchemaEvolution(t *testing.T) {
+	fake, registry := newFakeRegistry(t)
+
+	// the messages were published with the previous version of the schema, without the amount.
+	writerID := fake.add("orders-value", Avro, `{"type":"record","name":"Order","fields":[{"name":"id","type":"string"}]}`)
+
+	codec, err := NewAvroCodec(registry, CodecConfig{Schema: `{"type":"record","name":"Order","fields":[
+		{"name":"id","type":"string"},{"name":"amount","type":"double","default":1.5}]}`})
+	require.NoError(t, err)
+
+	var o order
+
+	require.NoError(t, codec.Decode(t.Context(), "orders", encodeWire(writerID, []byte{14, 'o', 'r', 'd', 'e', 'r', '-', '1'}), &o))
+	assert.Equal(t, order{ID: "order-1", Amount: 1.5}, o)
+
+	// the amount has no default in the schema of the codec, so the previous version cannot be resolved against it.
+	codec, err = NewAvroCodec(registry, CodecConfig{Schema: orderSchema})
+	require.NoError(t, err)
+
+	err = codec.Decode(t.Context(), "orders", encodeWire(writerID, []byte{14, 'o', 'r', 'd', 'e', 'r', '-', '1'}), &o)
+	require.ErrorIs(t, err, ErrIncompatibleSchema)
+	require.ErrorContains(t, err, "message of topic orders: incompatible schema: schema 1")
+}
+
+func TestAvroCodec_Errors(t *testing.T) {
+	fake, registry := newFakeRegistry(t)
+
+	_, err := NewAvroCodec(registry, CodecConfig{Schema: `{"type":"unknown"}`})
+	require.ErrorIs(t, err, ErrInvalidSchema)
+
+	codec, err := NewAvroCodec(registry, CodecConfig{Schema: orderSchema})
+	require.NoError(t, err)
+
+	// the schema is looked up without AutoRegister.
+	_, err = codec.Encode(t.Context(), "orders", order{ID: "order-1"})
+	require.ErrorIs(t, err, ErrSchemaNotFound)
+
+	_, err = codec.Encode(t.Context(), "orders", "order-1")
+	require.ErrorIs(t, err, ErrSchemaValidation)
+
+	var o order
+
+	require.ErrorIs(t, codec.Decode(t.Context(), "orders", []byte(`{"id":"order-1"}`), &o), ErrInvalidWireFormat)
+
+	jsonID := fake.add("payments-value", JSON, `{"type":"object"}`)
+	require.ErrorIs(t, codec.Decode(t.Context(), "orders", encodeWire(jsonID, nil), &o), ErrIncompatibleSchema)
+
+	require.ErrorIs(t, codec.Decode(t.Context(), "orders", encodeWire(10, nil), &o), ErrSchemaNotFound)
+
+	codec, err = NewAvroCodec(registry, CodecConfig{Schema: orderSchema, AutoRegister: true,
+		Subject: func(string) string { return "incompatible-value" }})
+	require.NoError(t, err)
+
+	_, err = codec.Encode(t.Context(), "orders", order{ID: "order-1"})
+	require.ErrorIs(t, err, ErrIncompatibleSchema)
+}
@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// JSONCodec encodes and decodes the JSON messages, validated against the JSON Schema of the codec when they are
+// published and when they are received.
+type JSONCodec struct {
+	codec
+	schema *jsonschema.Schema
+}
+
+// NewJSONCodec returns the codec of the JSON messages, with the JSON Schema of the config.
+func NewJSONCodec(registry *Registry, config CodecConfig) (*JSONCodec, error) {
+	schema, err := jsonschema.CompileString("schema.json", config.Schema)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSchema, err)
+	}
+
+	return &JSONCodec{codec: newCodec(registry, config, JSON), schema: schema}, nil
+}
+
+func (*JSONCodec) ContentType() string {
+	return "application/json"
+}
+
+// Encode encodes v as JSON, returning ErrSchemaValidation when it does not match the schema of the codec.
+func (c *JSONCodec) Encode(ctx context.Context, topic string, v any) ([]byte, error) {
+	value, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = c.validate(value); err != nil {
+		return nil, err
+	}
+
+	id, err := c.id(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeWire(id, value), nil
+}
+
+// Decode decodes the message into v, returning ErrSchemaValidation when it does not match the schema of the codec.
+func (c *JSONCodec) Decode(ctx context.Context, topic string, data []byte, v any) error {
+	id, value, err := decodeWire(data)
+	if err != nil {
+		return err
+	}
+
+	if _, err = c.writerSchema(ctx, id); err != nil {
+		return fmt.Errorf("message of topic %s: %w", topic, err)
+	}
+
+	if err = c.validate(value); err != nil {
+		return fmt.Errorf("message of topic %s with schema %d: %w", topic, id, err)
+	}
+
+	return json.Unmarshal(value, v)
+}
+
+func (c *JSONCodec) validate(value []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(value))
+	decoder.UseNumber()
+
+	var doc any
+
+	if err := decoder.Decode(&doc); err != nil {
+		return err
+	}
+
+	if err := c.schema.Validate(doc); err != nil {
+		return fmt.Errorf("%w: %w", ErrSchemaValidation, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const orderJSONSchema = `{
+	"type": "object",
+	"properties": {"id": {"type": "string"}, "amount": {"type": "number", "minimum": 0}},
+	"required": ["id"]
+}`
+
+func TestJSONCodec(t *testing.T) {
+	_, registry := newFakeRegistry(t)
+
+	codec, err := NewJSONCodec(registry, CodecConfig{Schema: orderJSONSchema, AutoRegister: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, "application/json", codec.ContentType())
+
+	data, err := codec.Encode(t.Context(), "orders", order{ID: "order-1", Amount: 9.5})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"order-1","amount":9.5}`, string(data[headerSize:]))
+
+	var o order
+
+	require.NoError(t, codec.Decode(t.Context(), "orders", data, &o))
+	assert.Equal(t, order{ID: "order-1", Amount: 9.5}, o)
+}
+
+func TestJSONCodec_Errors(t *testing.T) {
+	fake, registry := newFakeRegistry(t)
+
+	_, err := NewJSONCodec(registry, CodecConfig{Schema: `{"type": 1}`})
+	require.ErrorIs(t, err, ErrInvalidSchema)
+
+	codec, err := NewJSONCodec(registry, CodecConfig{Schema: orderJSONSchema})
+	require.NoError(t, err)
+
+	_, err = codec.Encode(t.Context(), "orders", order{ID: "order-1", Amount: -1})
+	require.ErrorIs(t, err, ErrSchemaValidation)
+
+	_, err = codec.Encode(t.Context(), "orders", order{ID: "order-1"})
+	require.ErrorIs(t, err, ErrSchemaNotFound)
+
+	_, err = codec.Encode(t.Context(), "orders", func() {})
+	require.Error(t, err)
+
+	var o order
+
+	id := fake.add("orders-value", JSON, `{"type":"object"}`)
+
+	err = codec.Decode(t.Context(), "orders", encodeWire(id, []byte(`{"amount":1}`)), &o)
+	require.ErrorIs(t, err, ErrSchemaValidation)
+	require.ErrorContains(t, err, "message of topic orders with schema 1")
+
+	require.Error(t, codec.Decode(t.Context(), "orders", encodeWire(id, []byte(`{`)), &o))
+	require.ErrorIs(t, codec.Decode(t.Context(), "orders", []byte(`{"id":"order-1"}`), &o), ErrInvalidWireFormat)
+
+	avroID := fake.add("payments-value", Avro, `"string"`)
+	require.ErrorIs(t, codec.Decode(t.Context(), "orders", encodeWire(avroID, nil), &o), ErrIncompatibleSchema)
+}
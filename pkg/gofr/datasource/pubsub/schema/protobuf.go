@@ -0,0 +1,126 @@
+package schema
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var errNotProtoMessage = errors.New("value is not a protobuf message")
+
+// ProtobufCodec encodes and decodes the Protobuf messages. The messages are prefixed with the indexes of their
+// message type in the .proto file of the schema, as in the wire format of the registry. A received message is
+// decoded into the Go type of the given message, the compatibility of the versions of the schema being checked
+// by the registry when they are registered.
+type ProtobufCodec struct {
+	codec
+}
+
+// NewProtobufCodec returns the codec of the Protobuf messages, with the .proto file of the messages as the schema
+// of the config.
+func NewProtobufCodec(registry *Registry, config CodecConfig) (*ProtobufCodec, error) {
+	if config.Schema == "" {
+		return nil, fmt.Errorf("%w: the .proto file of the messages is not set", ErrInvalidSchema)
+	}
+
+	return &ProtobufCodec{codec: newCodec(registry, config, Protobuf)}, nil
+}
+
+func (*ProtobufCodec) ContentType() string {
+	return "application/x-protobuf"
+}
+
+// Encode encodes v, which should be a proto.Message.
+func (c *ProtobufCodec) Encode(ctx context.Context, topic string, v any) ([]byte, error) {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", errNotProtoMessage, v)
+	}
+
+	value, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := c.id(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeWire(id, append(messageIndexes(msg.ProtoReflect().Descriptor()), value...)), nil
+}
+
+// Decode decodes the message into v, which should be a proto.Message.
+func (c *ProtobufCodec) Decode(ctx context.Context, topic string, data []byte, v any) error {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("%w: %T", errNotProtoMessage, v)
+	}
+
+	id, value, err := decodeWire(data)
+	if err != nil {
+		return err
+	}
+
+	if _, err = c.writerSchema(ctx, id); err != nil {
+		return fmt.Errorf("message of topic %s: %w", topic, err)
+	}
+
+	value, err = skipMessageIndexes(value)
+	if err != nil {
+		return err
+	}
+
+	return proto.Unmarshal(value, msg)
+}
+
+// messageIndexes returns the indexes of the message type in its .proto file, i.e. the index of its top-level message
+// followed by the indexes of the nested messages, encoded as zig-zag varints prefixed with their count. The indexes
+// [0] of the first message are encoded as a single 0.
+func messageIndexes(desc protoreflect.MessageDescriptor) []byte {
+	var indexes []int
+
+	for d := protoreflect.Descriptor(desc); ; d = d.Parent() {
+		if _, ok := d.(protoreflect.MessageDescriptor); !ok {
+			break
+		}
+
+		indexes = append([]int{d.Index()}, indexes...)
+	}
+
+	if len(indexes) == 1 && indexes[0] == 0 {
+		return []byte{0}
+	}
+
+	b := binary.AppendVarint(nil, int64(len(indexes)))
+
+	for _, i := range indexes {
+		b = binary.AppendVarint(b, int64(i))
+	}
+
+	return b
+}
+
+// skipMessageIndexes returns the encoded message following its message indexes.
+func skipMessageIndexes(data []byte) ([]byte, error) {
+	count, n := binary.Varint(data)
+	if n <= 0 || count < 0 {
+		return nil, ErrInvalidWireFormat
+	}
+
+	data = data[n:]
+
+	for range count {
+		if _, n = binary.Varint(data); n <= 0 {
+			return nil, ErrInvalidWireFormat
+		}
+
+		data = data[n:]
+	}
+
+	return data, nil
+}
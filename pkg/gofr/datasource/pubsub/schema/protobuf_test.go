@@ -0,0 +1,94 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const wrappersSchema = `syntax = "proto3";
+package google.protobuf;
+message DoubleValue { double value = 1; }
+message FloatValue { float value = 1; }
+message Int64Value { int64 value = 1; }
+message UInt64Value { uint64 value = 1; }
+message Int32Value { int32 value = 1; }
+message UInt32Value { uint32 value = 1; }
+message BoolValue { bool value = 1; }
+message StringValue { string value = 1; }
+message BytesValue { bytes value = 1; }`
+
+func TestProtobufCodec(t *testing.T) {
+	_, registry := newFakeRegistry(t)
+
+	codec, err := NewProtobufCodec(registry, CodecConfig{Schema: wrappersSchema, AutoRegister: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, "application/x-protobuf", codec.ContentType())
+
+	data, err := codec.Encode(t.Context(), "orders", wrapperspb.String("order-1"))
+	require.NoError(t, err)
+
+	// StringValue is the eighth message of the file.
+	assert.Equal(t, []byte{0, 0, 0, 0, 1, 2, 14}, data[:7])
+
+	var msg wrapperspb.StringValue
+
+	require.NoError(t, codec.Decode(t.Context(), "orders", data, &msg))
+	assert.Equal(t, "order-1", msg.GetValue())
+}
+
+func TestProtobufCodec_Errors(t *testing.T) {
+	fake, registry := newFakeRegistry(t)
+
+	_, err := NewProtobufCodec(registry, CodecConfig{})
+	require.ErrorIs(t, err, ErrInvalidSchema)
+
+	codec, err := NewProtobufCodec(registry, CodecConfig{Schema: wrappersSchema})
+	require.NoError(t, err)
+
+	_, err = codec.Encode(t.Context(), "orders", "order-1")
+	require.ErrorIs(t, err, errNotProtoMessage)
+
+	_, err = codec.Encode(t.Context(), "orders", wrapperspb.String("order-1"))
+	require.ErrorIs(t, err, ErrSchemaNotFound)
+
+	var msg wrapperspb.StringValue
+
+	require.ErrorIs(t, codec.Decode(t.Context(), "orders", nil, "order-1"), errNotProtoMessage)
+	require.ErrorIs(t, codec.Decode(t.Context(), "orders", []byte("order-1"), &msg), ErrInvalidWireFormat)
+
+	avroID := fake.add("payments-value", Avro, `"string"`)
+	require.ErrorIs(t, codec.Decode(t.Context(), "orders", encodeWire(avroID, nil), &msg), ErrIncompatibleSchema)
+
+	protoID := fake.add("orders-value", Protobuf, wrappersSchema)
+	require.ErrorIs(t, codec.Decode(t.Context(), "orders", encodeWire(protoID, []byte{4, 2}), &msg), ErrInvalidWireFormat)
+}
+
+func TestMessageIndexes(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		msg     proto.Message
+		indexes []byte
+	}{
+		{desc: "first message", msg: &durationpb.Duration{}, indexes: []byte{0}},
+		{desc: "top-level message", msg: &wrapperspb.StringValue{}, indexes: []byte{2, 14}},
+		{desc: "nested message", msg: &descriptorpb.DescriptorProto_ReservedRange{}, indexes: []byte{4, 4, 2}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			indexes := messageIndexes(tc.msg.ProtoReflect().Descriptor())
+			assert.Equal(t, tc.indexes, indexes)
+
+			value, err := skipMessageIndexes(append(indexes, 'v'))
+			require.NoError(t, err)
+			assert.Equal(t, []byte{'v'}, value)
+		})
+	}
+}
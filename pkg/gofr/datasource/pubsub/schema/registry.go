@@ -0,0 +1,254 @@
+// Package schema provides the schema-aware codecs of the pub/sub messages, Avro, Protobuf and JSON Schema, with the
+// schemas in a Confluent-compatible schema registry. The encoded messages are in the wire format of the registry,
+// i.e. a magic byte and the ID of their schema followed by the encoded value, so that they are interoperable with
+// the other clients of the registry.
+package schema
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultTimeout = 10 * time.Second
+	contentType    = "application/vnd.schemaregistry.v1+json"
+)
+
+var (
+	// ErrIncompatibleSchema is returned when a schema is incompatible with the schemas registered under its subject,
+	// or when the schema of a received message is incompatible with the schema of the codec.
+	ErrIncompatibleSchema = errors.New("incompatible schema")
+	// ErrSchemaNotFound is returned when a schema or a subject is not found in the registry.
+	ErrSchemaNotFound = errors.New("schema not found")
+	// ErrInvalidSchema is returned when a schema is invalid.
+	ErrInvalidSchema = errors.New("invalid schema")
+	// ErrSchemaValidation is returned when a message does not match the schema of the codec.
+	ErrSchemaValidation = errors.New("message does not match the schema")
+	// ErrInvalidWireFormat is returned when a received message is not in the wire format of the registry.
+	ErrInvalidWireFormat = errors.New("message is not in the wire format of the schema registry")
+)
+
+// Type is the type of a schema in the registry.
+type Type string
+
+const (
+	Avro     Type = "AVRO"
+	Protobuf Type = "PROTOBUF"
+	JSON     Type = "JSON"
+)
+
+// Schema is a schema registered in the registry.
+type Schema struct {
+	ID         int
+	Type       Type
+	Definition string
+}
+
+// RegistryConfig is the configuration of the schema registry client.
+type RegistryConfig struct {
+	// URL is the URL of the schema registry, e.g. http://localhost:8081.
+	URL string
+	// Username and Password are the credentials of the basic authentication of the registry, e.g. the API key and
+	// secret of Confluent Cloud.
+	Username string
+	Password string
+	// Timeout is the timeout of the requests to the registry. It defaults to 10 seconds.
+	Timeout time.Duration
+}
+
+// RegistryError is the error returned by the registry.
+type RegistryError struct {
+	StatusCode int    `json:"-"`
+	Code       int    `json:"error_code"`
+	Message    string `json:"message"`
+}
+
+func (e *RegistryError) Error() string {
+	return fmt.Sprintf("schema registry error %d: %s", e.Code, e.Message)
+}
+
+// Is reports whether the error is ErrIncompatibleSchema, ErrSchemaNotFound or ErrInvalidSchema, as per its status.
+func (e *RegistryError) Is(target error) bool {
+	switch e.StatusCode {
+	case http.StatusConflict:
+		return target == ErrIncompatibleSchema
+	case http.StatusNotFound:
+		return target == ErrSchemaNotFound
+	case http.StatusUnprocessableEntity:
+		return target == ErrInvalidSchema
+	default:
+		return false
+	}
+}
+
+// Registry is the client of a Confluent-compatible schema registry, caching the IDs of the schemas of the subjects
+// and the schemas of the IDs, which are immutable in the registry.
+type Registry struct {
+	config RegistryConfig
+	client *http.Client
+
+	mu      sync.RWMutex
+	ids     map[subjectSchema]int
+	schemas map[int]Schema
+}
+
+type subjectSchema struct {
+	subject    string
+	definition string
+}
+
+type schemaRequest struct {
+	Schema     string `json:"schema"`
+	SchemaType Type   `json:"schemaType,omitempty"`
+}
+
+type schemaResponse struct {
+	ID         int    `json:"id"`
+	Schema     string `json:"schema"`
+	SchemaType Type   `json:"schemaType"`
+}
+
+// NewRegistry returns a client of the schema registry, with the defaults set for the missing values of the config.
+func NewRegistry(config RegistryConfig) *Registry {
+	if config.Timeout <= 0 {
+		config.Timeout = defaultTimeout
+	}
+
+	config.URL = strings.TrimSuffix(config.URL, "/")
+
+	return &Registry{
+		config:  config,
+		client:  &http.Client{Timeout: config.Timeout},
+		ids:     make(map[subjectSchema]int),
+		schemas: make(map[int]Schema),
+	}
+}
+
+// Register registers the schema under the subject, returning its ID. The ID of the schema is returned when it is
+// already registered, and ErrIncompatibleSchema when it is incompatible with the schemas of the subject.
+func (r *Registry) Register(ctx context.Context, subject string, typ Type, definition string) (int, error) {
+	return r.id(ctx, "/subjects/"+url.PathEscape(subject)+"/versions", subject, typ, definition)
+}
+
+// Lookup returns the ID of the schema registered under the subject, or ErrSchemaNotFound when it is not registered.
+func (r *Registry) Lookup(ctx context.Context, subject string, typ Type, definition string) (int, error) {
+	return r.id(ctx, "/subjects/"+url.PathEscape(subject), subject, typ, definition)
+}
+
+func (r *Registry) id(ctx context.Context, path, subject string, typ Type, definition string) (int, error) {
+	key := subjectSchema{subject: subject, definition: definition}
+
+	r.mu.RLock()
+	id, ok := r.ids[key]
+	r.mu.RUnlock()
+
+	if ok {
+		return id, nil
+	}
+
+	// the type is omitted for Avro, the default type of the registry.
+	request := schemaRequest{Schema: definition}
+	if typ != Avro {
+		request.SchemaType = typ
+	}
+
+	var response schemaResponse
+
+	if err := r.do(ctx, http.MethodPost, path, request, &response); err != nil {
+		return 0, fmt.Errorf("subject %s: %w", subject, err)
+	}
+
+	r.mu.Lock()
+	r.ids[key] = response.ID
+	r.schemas[response.ID] = Schema{ID: response.ID, Type: typ, Definition: definition}
+	r.mu.Unlock()
+
+	return response.ID, nil
+}
+
+// SchemaByID returns the schema with the ID.
+func (r *Registry) SchemaByID(ctx context.Context, id int) (Schema, error) {
+	r.mu.RLock()
+	schema, ok := r.schemas[id]
+	r.mu.RUnlock()
+
+	if ok {
+		return schema, nil
+	}
+
+	var response schemaResponse
+
+	if err := r.do(ctx, http.MethodGet, "/schemas/ids/"+strconv.Itoa(id), nil, &response); err != nil {
+		return Schema{}, fmt.Errorf("schema %d: %w", id, err)
+	}
+
+	schema = Schema{ID: id, Type: response.SchemaType, Definition: response.Schema}
+
+	// the type is omitted for Avro, the default type of the registry.
+	if schema.Type == "" {
+		schema.Type = Avro
+	}
+
+	r.mu.Lock()
+	r.schemas[id] = schema
+	r.mu.Unlock()
+
+	return schema, nil
+}
+
+func (r *Registry) do(ctx context.Context, method, path string, body, result any) error {
+	var reader io.Reader
+
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+
+		reader = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, r.config.URL+path, reader)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Accept", contentType)
+
+	if body != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	if r.config.Username != "" {
+		req.SetBasicAuth(r.config.Username, r.config.Password)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		registryErr := &RegistryError{StatusCode: resp.StatusCode}
+
+		if err = json.NewDecoder(resp.Body).Decode(registryErr); err != nil {
+			registryErr.Code, registryErr.Message = resp.StatusCode, resp.Status
+		}
+
+		return registryErr
+	}
+
+	return json.NewDecoder(resp.Body).Decode(result)
+}
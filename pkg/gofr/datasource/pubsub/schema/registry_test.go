@@ -0,0 +1,237 @@
+package schema
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRegistry is a schema registry in memory, rejecting the schemas of the subject incompatible-value.
+type fakeRegistry struct {
+	mu       sync.Mutex
+	schemas  []schemaResponse
+	subjects map[string][]int
+	requests int
+}
+
+func newFakeRegistry(t *testing.T) (*fakeRegistry, *Registry) {
+	t.Helper()
+
+	f := &fakeRegistry{subjects: make(map[string][]int)}
+
+	server := httptest.NewServer(f)
+	t.Cleanup(server.Close)
+
+	return f, NewRegistry(RegistryConfig{URL: server.URL + "/", Username: "key", Password: "secret"})
+}
+
+// add registers the schema under the subject, returning its ID.
+func (f *fakeRegistry) add(subject string, typ Type, definition string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, s := range f.schemas {
+		if s.Schema == definition && s.SchemaType == typ {
+			f.subjects[subject] = append(f.subjects[subject], s.ID)
+
+			return s.ID
+		}
+	}
+
+	id := len(f.schemas) + 1
+
+	f.schemas = append(f.schemas, schemaResponse{ID: id, Schema: definition, SchemaType: typ})
+	f.subjects[subject] = append(f.subjects[subject], id)
+
+	return id
+}
+
+func (f *fakeRegistry) lookup(subject, definition string) (int, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, id := range f.subjects[subject] {
+		if f.schemas[id-1].Schema == definition {
+			return id, true
+		}
+	}
+
+	return 0, false
+}
+
+func (f *fakeRegistry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	f.requests++
+	f.mu.Unlock()
+
+	if user, password, _ := r.BasicAuth(); user != "key" || password != "secret" {
+		writeError(w, http.StatusUnauthorized, 40101, "Unauthorized")
+
+		return
+	}
+
+	path := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+	switch {
+	case r.Method == http.MethodGet && len(path) == 3 && path[0] == "schemas":
+		id, _ := strconv.Atoi(path[2])
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		if id < 1 || id > len(f.schemas) {
+			writeError(w, http.StatusNotFound, 40403, "Schema not found")
+
+			return
+		}
+
+		s := f.schemas[id-1]
+
+		// the type is omitted for Avro.
+		if s.SchemaType == Avro {
+			s.SchemaType = ""
+		}
+
+		_ = json.NewEncoder(w).Encode(schemaResponse{Schema: s.Schema, SchemaType: s.SchemaType})
+	case r.Method == http.MethodPost && path[0] == "subjects":
+		var req schemaRequest
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Schema == "" {
+			writeError(w, http.StatusUnprocessableEntity, 42201, "Invalid schema")
+
+			return
+		}
+
+		if req.SchemaType == "" {
+			req.SchemaType = Avro
+		}
+
+		f.handleSubject(w, path, req)
+	default:
+		writeError(w, http.StatusNotFound, 404, "Not found")
+	}
+}
+
+func (f *fakeRegistry) handleSubject(w http.ResponseWriter, path []string, req schemaRequest) {
+	subject := path[1]
+
+	if len(path) == 3 {
+		if subject == "incompatible-value" {
+			writeError(w, http.StatusConflict, 409, "Schema being registered is incompatible with an earlier schema")
+
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(schemaResponse{ID: f.add(subject, req.SchemaType, req.Schema)})
+
+		return
+	}
+
+	id, ok := f.lookup(subject, req.Schema)
+	if !ok {
+		writeError(w, http.StatusNotFound, 40403, "Schema not found")
+
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(schemaResponse{ID: id})
+}
+
+func writeError(w http.ResponseWriter, status, code int, message string) {
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(RegistryError{Code: code, Message: message})
+}
+
+func TestRegistry_RegisterAndLookup(t *testing.T) {
+	fake, registry := newFakeRegistry(t)
+
+	_, err := registry.Lookup(t.Context(), "orders-value", Avro, `"string"`)
+	require.ErrorIs(t, err, ErrSchemaNotFound)
+
+	id, err := registry.Register(t.Context(), "orders-value", Avro, `"string"`)
+	require.NoError(t, err)
+	assert.Equal(t, 1, id)
+
+	id, err = registry.Lookup(t.Context(), "orders-value", Avro, `"string"`)
+	require.NoError(t, err)
+	assert.Equal(t, 1, id)
+
+	// the IDs and the schemas are cached.
+	requests := fake.requests
+
+	_, err = registry.Register(t.Context(), "orders-value", Avro, `"string"`)
+	require.NoError(t, err)
+
+	schema, err := registry.SchemaByID(t.Context(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, Schema{ID: 1, Type: Avro, Definition: `"string"`}, schema)
+	assert.Equal(t, requests, fake.requests)
+}
+
+func TestRegistry_SchemaByID(t *testing.T) {
+	fake, registry := newFakeRegistry(t)
+
+	fake.add("orders-value", Avro, `"string"`)
+	fake.add("payments-value", JSON, `{"type":"object"}`)
+
+	schema, err := registry.SchemaByID(t.Context(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, Schema{ID: 1, Type: Avro, Definition: `"string"`}, schema)
+
+	schema, err = registry.SchemaByID(t.Context(), 2)
+	require.NoError(t, err)
+	assert.Equal(t, Schema{ID: 2, Type: JSON, Definition: `{"type":"object"}`}, schema)
+
+	_, err = registry.SchemaByID(t.Context(), 3)
+	require.ErrorIs(t, err, ErrSchemaNotFound)
+}
+
+func TestRegistry_Errors(t *testing.T) {
+	_, registry := newFakeRegistry(t)
+
+	_, err := registry.Register(t.Context(), "incompatible-value", Avro, `"string"`)
+	require.ErrorIs(t, err, ErrIncompatibleSchema)
+	require.ErrorContains(t, err, "subject incompatible-value: schema registry error 409")
+
+	_, err = registry.Register(t.Context(), "orders-value", Avro, "")
+	require.ErrorIs(t, err, ErrInvalidSchema)
+
+	var registryErr *RegistryError
+
+	registry.config.Username = "unknown"
+
+	_, err = registry.SchemaByID(t.Context(), 1)
+	require.ErrorAs(t, err, &registryErr)
+	assert.Equal(t, http.StatusUnauthorized, registryErr.StatusCode)
+	assert.Equal(t, 40101, registryErr.Code)
+	assert.NotErrorIs(t, err, ErrSchemaNotFound)
+
+	registry = NewRegistry(RegistryConfig{URL: "http://localhost:1"})
+
+	_, err = registry.SchemaByID(t.Context(), 1)
+	require.Error(t, err)
+}
+
+func TestWireFormat(t *testing.T) {
+	data := encodeWire(258, []byte("value"))
+	assert.Equal(t, []byte{0, 0, 0, 1, 2, 'v', 'a', 'l', 'u', 'e'}, data)
+
+	id, value, err := decodeWire(data)
+	require.NoError(t, err)
+	assert.Equal(t, 258, id)
+	assert.Equal(t, []byte("value"), value)
+
+	_, _, err = decodeWire([]byte(`{"id":1}`))
+	require.ErrorIs(t, err, ErrInvalidWireFormat)
+
+	_, _, err = decodeWire([]byte{0, 0})
+	require.ErrorIs(t, err, ErrInvalidWireFormat)
+}
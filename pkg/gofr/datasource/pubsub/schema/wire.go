@@ -0,0 +1,88 @@
+package schema
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+)
+
+const (
+	magicByte  = 0
+	headerSize = 5
+)
+
+// CodecConfig is the configuration of a schema-aware codec.
+type CodecConfig struct {
+	// Schema is the definition of the schema of the messages, i.e. the Avro schema, the .proto file of the Protobuf
+	// messages, or the JSON Schema.
+	Schema string
+	// Subject returns the subject of the schema of the messages of a topic. It defaults to TopicNameStrategy.
+	Subject func(topic string) string
+	// AutoRegister registers the schema under the subject of a topic when a message is published to it. Otherwise,
+	// the schema is to be registered beforehand, and its ID is looked up.
+	AutoRegister bool
+}
+
+// TopicNameStrategy returns the subject <topic>-value of the messages of the topic, the default subject name
+// strategy of the registry.
+func TopicNameStrategy(topic string) string {
+	return topic + "-value"
+}
+
+// codec is the registry and the config of a schema-aware codec.
+type codec struct {
+	registry *Registry
+	config   CodecConfig
+	typ      Type
+}
+
+func newCodec(registry *Registry, config CodecConfig, typ Type) codec {
+	if config.Subject == nil {
+		config.Subject = TopicNameStrategy
+	}
+
+	return codec{registry: registry, config: config, typ: typ}
+}
+
+// id returns the ID of the schema of the codec under the subject of the topic, registering the schema when
+// AutoRegister is set.
+func (c *codec) id(ctx context.Context, topic string) (int, error) {
+	if c.config.AutoRegister {
+		return c.registry.Register(ctx, c.config.Subject(topic), c.typ, c.config.Schema)
+	}
+
+	return c.registry.Lookup(ctx, c.config.Subject(topic), c.typ, c.config.Schema)
+}
+
+// writerSchema returns the schema with which the message was encoded, which should be of the type of the codec.
+func (c *codec) writerSchema(ctx context.Context, id int) (Schema, error) {
+	schema, err := c.registry.SchemaByID(ctx, id)
+	if err != nil {
+		return Schema{}, err
+	}
+
+	if schema.Type != c.typ {
+		return Schema{}, fmt.Errorf("%w: message of %s schema %d decoded as %s", ErrIncompatibleSchema, schema.Type, id, c.typ)
+	}
+
+	return schema, nil
+}
+
+// encodeWire returns the message in the wire format of the registry, the magic byte and the ID of the schema
+// followed by the encoded value.
+func encodeWire(id int, value []byte) []byte {
+	data := make([]byte, headerSize, headerSize+len(value))
+	data[0] = magicByte
+	binary.BigEndian.PutUint32(data[1:], uint32(id)) //nolint:gosec // the IDs of the registry are 32-bit.
+
+	return append(data, value...)
+}
+
+// decodeWire returns the ID of the schema and the encoded value of the message in the wire format of the registry.
+func decodeWire(data []byte) (int, []byte, error) {
+	if len(data) < headerSize || data[0] != magicByte {
+		return 0, nil, ErrInvalidWireFormat
+	}
+
+	return int(binary.BigEndian.Uint32(data[1:headerSize])), data[headerSize:], nil
+}
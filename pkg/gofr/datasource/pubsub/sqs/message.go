@@ -0,0 +1,78 @@
+package sqs
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqsTypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+)
+
+// message is a received SQS message, the visibility of which is extended every half of the visibility timeout,
+// so that it is not redelivered while it is handled. It is deleted from the queue when committed.
+type message struct {
+	client   *Client
+	queueURL string
+	msg      sqsTypes.Message
+
+	done chan struct{}
+	once sync.Once
+}
+
+func newMessage(c *Client, queueURL string, msg sqsTypes.Message) *message {
+	m := &message{client: c, queueURL: queueURL, msg: msg, done: make(chan struct{})}
+
+	go m.extendVisibility()
+
+	return m
+}
+
+func (m *message) Commit() {
+	m.stop()
+
+	_, err := m.client.sqs.DeleteMessage(context.Background(), &sqs.DeleteMessageInput{
+		QueueUrl:      aws.String(m.queueURL),
+		ReceiptHandle: m.msg.ReceiptHandle,
+	})
+	if err != nil {
+		m.client.logger.Errorf("failed to delete SQS message %s: %v", aws.ToString(m.msg.MessageId), err)
+	}
+}
+
+// Reject stops extending the visibility of the message, which is redelivered once its visibility timeout expires,
+// or moved to the dead-letter queue of the queue after its maximum receives.
+func (m *message) Reject() {
+	m.stop()
+}
+
+func (m *message) stop() {
+	m.once.Do(func() {
+		close(m.done)
+		m.client.done(m)
+	})
+}
+
+func (m *message) extendVisibility() {
+	ticker := time.NewTicker(m.client.config.VisibilityTimeout / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.done:
+			return
+		case <-ticker.C:
+			_, err := m.client.sqs.ChangeMessageVisibility(context.Background(), &sqs.ChangeMessageVisibilityInput{
+				QueueUrl:          aws.String(m.queueURL),
+				ReceiptHandle:     m.msg.ReceiptHandle,
+				VisibilityTimeout: m.client.visibilityTimeout(),
+			})
+			if err != nil {
+				m.client.logger.Errorf("failed to extend visibility of SQS message %s: %v", aws.ToString(m.msg.MessageId), err)
+
+				return
+			}
+		}
+	}
+}
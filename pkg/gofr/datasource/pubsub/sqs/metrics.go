@@ -0,0 +1,7 @@
+package sqs
+
+import "context"
+
+type Metrics interface {
+	IncrementCounter(ctx context.Context, name string, labels ...string)
+}
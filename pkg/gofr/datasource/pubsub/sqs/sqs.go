@@ -0,0 +1,556 @@
+// Package sqs provides a pub/sub client on AWS, publishing the messages to SNS topics and reading them from SQS queues.
+// Each consumer group reads the messages of a topic from its own queue, subscribed to the topic with raw message
+// delivery, so that the headers of the messages are delivered as the message attributes.
+package sqs
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsConfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	snsTypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqsTypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"gofr.dev/pkg/gofr/datasource"
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+)
+
+const (
+	// DefaultMessageGroupID is the message group of the messages published to a FIFO topic without a key,
+	// when MessageGroupID is not set.
+	DefaultMessageGroupID = "gofr"
+	// KeyAttribute is the message attribute carrying the key of a message.
+	KeyAttribute = "x-message-key"
+
+	defaultMaxMessages       = 10
+	defaultWaitTime          = 20 * time.Second
+	defaultVisibilityTimeout = 30 * time.Second
+	defaultQueryLimit        = 10
+	healthTimeout            = 5 * time.Second
+
+	fifoSuffix = ".fifo"
+)
+
+var (
+	errEmptyTopicName      = errors.New("topic name cannot be empty")
+	errClientClosed        = errors.New("sqs pubsub client is closed")
+	errClientNotConnected  = errors.New("sqs pubsub client is not connected")
+	errTopicNotFound       = errors.New("SNS topic ARN not returned")
+	errQueueNotFound       = errors.New("SQS queue attributes not returned")
+	errRegionNotConfigured = errors.New("AWS region is not configured")
+)
+
+// Config is the configuration of the SNS/SQS pub/sub client.
+type Config struct {
+	// Region is the AWS region of the topics and queues.
+	Region string
+	// EndPoint is the endpoint of SNS and SQS, e.g. of LocalStack or ElasticMQ. The endpoints of the region
+	// are used when it is not set.
+	EndPoint string
+	// AccessKeyID and SecretAccessKey are the static credentials of the client. The default credentials of the
+	// environment, e.g. of the IAM role of the service, are used when they are not set.
+	AccessKeyID     string
+	SecretAccessKey string
+	// ConsumerGroupID is the consumer group of the subscribers. The messages of a topic are read from the queue
+	// <ConsumerGroupID>-<topic>, or from the queue <topic> when it is not set.
+	ConsumerGroupID string
+	// MessageGroupID is the message group of the messages published to a FIFO topic without a key.
+	// It defaults to DefaultMessageGroupID.
+	MessageGroupID string
+	// MaxMessages is the number of messages received at once, from 1 to 10. It defaults to 10.
+	MaxMessages int
+	// WaitTime is the time for which a receive waits for a message, up to 20 seconds. It defaults to 20 seconds.
+	WaitTime time.Duration
+	// VisibilityTimeout is the time for which a received message is hidden from the other subscribers. It is
+	// extended while the message is handled, until it is committed or rejected. It defaults to 30 seconds.
+	VisibilityTimeout time.Duration
+}
+
+// Client is the pub/sub client on SNS and SQS. A topic is an SNS topic, and a topic ending with .fifo is a FIFO topic,
+// read from FIFO queues, in which the messages with the same key are delivered in order.
+type Client struct {
+	sqs     SQS
+	sns     SNS
+	config  Config
+	logger  pubsub.Logger
+	metrics Metrics
+	tracer  trace.Tracer
+
+	mu sync.Mutex
+	// topicARNs are the ARNs of the SNS topics.
+	topicARNs map[string]string
+	// queues are the queues of the consumer group for the topics.
+	queues map[string]*queue
+	// messages are the received messages, the visibility of which is extended until they are committed or rejected.
+	messages map[*message]struct{}
+	closed   bool
+}
+
+// queue is the queue of a topic for the consumer group, with its received messages which are yet to be returned.
+type queue struct {
+	url      string
+	received []*message
+}
+
+// New returns an SNS/SQS pub/sub client, with the defaults set for the missing values of the config. It is added
+// to the app with AddPubSub, which connects it:
+//
+//	app.AddPubSub(sqs.New(sqs.Config{Region: "us-east-1", ConsumerGroupID: "order-service"}))
+func New(conf Config) *Client {
+	if conf.MessageGroupID == "" {
+		conf.MessageGroupID = DefaultMessageGroupID
+	}
+
+	if conf.MaxMessages <= 0 || conf.MaxMessages > defaultMaxMessages {
+		conf.MaxMessages = defaultMaxMessages
+	}
+
+	if conf.WaitTime <= 0 || conf.WaitTime > defaultWaitTime {
+		conf.WaitTime = defaultWaitTime
+	}
+
+	if conf.VisibilityTimeout < time.Second {
+		conf.VisibilityTimeout = defaultVisibilityTimeout
+	}
+
+	return &Client{
+		config:    conf,
+		tracer:    otel.GetTracerProvider().Tracer("gofr"),
+		topicARNs: make(map[string]string),
+		queues:    make(map[string]*queue),
+		messages:  make(map[*message]struct{}),
+	}
+}
+
+// UseLogger sets the logger for the SNS/SQS client.
+func (c *Client) UseLogger(logger any) {
+	if l, ok := logger.(pubsub.Logger); ok {
+		c.logger = l
+	}
+}
+
+// UseMetrics sets the metrics for the SNS/SQS client.
+func (c *Client) UseMetrics(metrics any) {
+	if m, ok := metrics.(Metrics); ok {
+		c.metrics = m
+	}
+}
+
+// UseTracer sets the tracer for the SNS/SQS client.
+func (c *Client) UseTracer(tracer any) {
+	if t, ok := tracer.(trace.Tracer); ok {
+		c.tracer = t
+	}
+}
+
+// Connect creates the SNS and SQS clients with the credentials of the config, or the default credentials of the
+// environment, e.g. of the IAM role of the service.
+func (c *Client) Connect() {
+	opts := []func(*awsConfig.LoadOptions) error{awsConfig.WithRegion(c.config.Region)}
+
+	if c.config.AccessKeyID != "" {
+		opts = append(opts, awsConfig.WithCredentialsProvider(
+			credentials.NewStaticCredentialsProvider(c.config.AccessKeyID, c.config.SecretAccessKey, "")))
+	}
+
+	cfg, err := awsConfig.LoadDefaultConfig(context.Background(), opts...)
+	if err != nil {
+		c.logger.Errorf("could not initialize sqs pubsub, error: %v", err)
+
+		return
+	}
+
+	// the region is resolved from the environment of the AWS SDK when it is not configured.
+	if cfg.Region == "" {
+		c.logger.Errorf("could not initialize sqs pubsub, error: %v", errRegionNotConfigured)
+
+		return
+	}
+
+	c.config.Region = cfg.Region
+
+	sqsClient := sqs.NewFromConfig(cfg, func(o *sqs.Options) {
+		if c.config.EndPoint != "" {
+			o.BaseEndpoint = aws.String(c.config.EndPoint)
+		}
+	})
+
+	snsClient := sns.NewFromConfig(cfg, func(o *sns.Options) {
+		if c.config.EndPoint != "" {
+			o.BaseEndpoint = aws.String(c.config.EndPoint)
+		}
+	})
+
+	c.mu.Lock()
+	c.sqs, c.sns = sqsClient, snsClient
+	c.mu.Unlock()
+
+	c.logger.Debugf("using SNS/SQS pubsub in region '%s' with consumer group '%s'", cfg.Region, c.config.ConsumerGroupID)
+}
+
+func (c *Client) Publish(ctx context.Context, topic string, message []byte) error {
+	return c.PublishWithOptions(ctx, topic, message, pubsub.PublishOptions{})
+}
+
+// PublishWithOptions publishes the message to the SNS topic, with the headers of the options, the key and the trace
+// context as the message attributes. The message of a FIFO topic is published to the message group of its key.
+// The SNS messages being text, the message is to be text as well, e.g. JSON.
+func (c *Client) PublishWithOptions(ctx context.Context, topic string, message []byte, options pubsub.PublishOptions) error {
+	ctx, span := c.tracer.Start(ctx, "sqs-publish")
+	defer span.End()
+
+	c.metrics.IncrementCounter(ctx, "app_pubsub_publish_total_count", "topic", topic)
+
+	if topic == "" {
+		return errEmptyTopicName
+	}
+
+	if err := c.usable(); err != nil {
+		return err
+	}
+
+	start := time.Now()
+
+	arn, err := c.topicARN(ctx, topic)
+	if err != nil {
+		c.logger.Errorf("failed to resolve SNS topic %s, error: %v", topic, err)
+
+		return err
+	}
+
+	attributes := make(map[string]snsTypes.MessageAttributeValue)
+
+	for k, v := range pubsub.InjectTraceContext(ctx, options.AllHeaders()) {
+		attributes[k] = snsTypes.MessageAttributeValue{DataType: aws.String("String"), StringValue: aws.String(v)}
+	}
+
+	if options.Key != "" {
+		attributes[KeyAttribute] = snsTypes.MessageAttributeValue{DataType: aws.String("String"),
+			StringValue: aws.String(options.Key)}
+	}
+
+	input := &sns.PublishInput{
+		TopicArn:          aws.String(arn),
+		Message:           aws.String(string(message)),
+		MessageAttributes: attributes,
+	}
+
+	if isFIFO(topic) {
+		input.MessageGroupId = aws.String(c.config.MessageGroupID)
+
+		if options.Key != "" {
+			input.MessageGroupId = aws.String(options.Key)
+		}
+
+		input.MessageDeduplicationId = aws.String(uuid.NewString())
+	}
+
+	if _, err = c.sns.Publish(ctx, input); err != nil {
+		c.logger.Errorf("failed to publish message to SNS topic %s, error: %v", topic, err)
+
+		return err
+	}
+
+	c.logger.Debug(&pubsub.Log{
+		Mode:          "PUB",
+		CorrelationID: span.SpanContext().TraceID().String(),
+		MessageValue:  string(message),
+		Topic:         topic,
+		Host:          c.config.Region,
+		PubSubBackend: "SQS",
+		Time:          time.Since(start).Microseconds(),
+	})
+
+	c.metrics.IncrementCounter(ctx, "app_pubsub_publish_success_count", "topic", topic)
+
+	return nil
+}
+
+// Subscribe returns the next message of the queue of the topic for the consumer group, waiting for it until ctx
+// is done. The queue is created and subscribed to the topic when it does not exist. The visibility of the message
+// is extended until it is committed, when it is deleted from the queue, or rejected, when it is redelivered once
+// its visibility timeout expires.
+func (c *Client) Subscribe(ctx context.Context, topic string) (*pubsub.Message, error) {
+	if topic == "" {
+		return nil, errEmptyTopicName
+	}
+
+	if err := c.usable(); err != nil {
+		return nil, err
+	}
+
+	q, err := c.queue(ctx, topic)
+	if err != nil {
+		c.logger.Errorf("failed to resolve SQS queue of topic %s, error: %v", topic, err)
+
+		return nil, err
+	}
+
+	ctx, span := c.tracer.Start(ctx, "sqs-subscribe")
+	defer span.End()
+
+	c.metrics.IncrementCounter(ctx, "app_pubsub_subscribe_total_count", "topic", topic,
+		"consumer_group", c.config.ConsumerGroupID)
+
+	start := time.Now()
+
+	var m *message
+
+	for m == nil {
+		if err = c.receive(ctx, q); err != nil {
+			if ctx.Err() == nil {
+				c.logger.Errorf("failed to receive message from SQS queue of topic %s: %v", topic, err)
+			}
+
+			return nil, err
+		}
+
+		m = c.next(q)
+	}
+
+	msg := pubsub.NewMessage(ctx)
+	msg.Topic = topic
+	msg.Value = []byte(aws.ToString(m.msg.Body))
+	msg.Key = messageKey(&m.msg)
+	msg.SetHeaders(messageHeaders(&m.msg))
+	msg.Committer = m
+
+	c.logger.Debug(&pubsub.Log{
+		Mode:          "SUB",
+		CorrelationID: span.SpanContext().TraceID().String(),
+		MessageValue:  string(msg.Value),
+		Topic:         topic,
+		Host:          c.config.Region,
+		PubSubBackend: "SQS",
+		Time:          time.Since(start).Microseconds(),
+	})
+
+	c.metrics.IncrementCounter(ctx, "app_pubsub_subscribe_success_count", "topic", topic,
+		"consumer_group", c.config.ConsumerGroupID)
+
+	return msg, nil
+}
+
+// receive receives the messages of the queue, when none of its received messages is yet to be returned.
+func (c *Client) receive(ctx context.Context, q *queue) error {
+	c.mu.Lock()
+	pending := len(q.received)
+	c.mu.Unlock()
+
+	if pending > 0 {
+		return nil
+	}
+
+	out, err := c.sqs.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+		QueueUrl:                    aws.String(q.url),
+		MaxNumberOfMessages:         int32(c.config.MaxMessages),        //nolint:gosec // MaxMessages is at most 10.
+		WaitTimeSeconds:             int32(c.config.WaitTime.Seconds()), //nolint:gosec // WaitTime is at most 20s.
+		VisibilityTimeout:           c.visibilityTimeout(),
+		MessageAttributeNames:       []string{"All"},
+		MessageSystemAttributeNames: []sqsTypes.MessageSystemAttributeName{sqsTypes.MessageSystemAttributeNameMessageGroupId},
+	})
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i := range out.Messages {
+		m := newMessage(c, q.url, out.Messages[i])
+
+		c.messages[m] = struct{}{}
+		q.received = append(q.received, m)
+	}
+
+	return ctx.Err()
+}
+
+// next returns the next received message of the queue, or nil when none is received.
+func (c *Client) next(q *queue) *message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(q.received) == 0 {
+		return nil
+	}
+
+	m := q.received[0]
+	q.received = q.received[1:]
+
+	return m
+}
+
+// done stops tracking the message, once it is committed or rejected.
+func (c *Client) done(m *message) {
+	c.mu.Lock()
+	delete(c.messages, m)
+	c.mu.Unlock()
+}
+
+func (c *Client) visibilityTimeout() int32 {
+	return int32(c.config.VisibilityTimeout.Seconds()) //nolint:gosec // the visibility timeout is at most 12 hours.
+}
+
+// Query returns the bodies of the messages at the head of the queue of the topic, separated by new lines, without
+// removing them. The arg is the maximum number of messages as int, 10 by default and at most 10.
+func (c *Client) Query(ctx context.Context, query string, args ...any) ([]byte, error) {
+	if query == "" {
+		return nil, errEmptyTopicName
+	}
+
+	if err := c.usable(); err != nil {
+		return nil, err
+	}
+
+	limit := defaultQueryLimit
+
+	if len(args) > 0 {
+		if val, ok := args[0].(int); ok && val > 0 && val < defaultQueryLimit {
+			limit = val
+		}
+	}
+
+	url, err := c.queueURL(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := c.sqs.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+		QueueUrl:            aws.String(url),
+		MaxNumberOfMessages: int32(limit), //nolint:gosec // limit is at most 10.
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []byte
+
+	for i := range out.Messages {
+		// the messages are made visible again for the subscribers.
+		_, err = c.sqs.ChangeMessageVisibility(ctx, &sqs.ChangeMessageVisibilityInput{
+			QueueUrl:      aws.String(url),
+			ReceiptHandle: out.Messages[i].ReceiptHandle,
+		})
+		if err != nil {
+			c.logger.Errorf("failed to release queried message of SQS queue of topic %s: %v", query, err)
+		}
+
+		if len(result) > 0 {
+			result = append(result, '\n')
+		}
+
+		result = append(result, aws.ToString(out.Messages[i].Body)...)
+	}
+
+	return result, nil
+}
+
+func (c *Client) Health() datasource.Health {
+	res := datasource.Health{
+		Status: datasource.StatusDown,
+		Details: map[string]any{
+			"backend":        "SQS",
+			"region":         c.config.Region,
+			"consumer_group": c.config.ConsumerGroupID,
+		},
+	}
+
+	if err := c.usable(); err != nil {
+		res.Details["error"] = err.Error()
+
+		return res
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), healthTimeout)
+	defer cancel()
+
+	if _, err := c.sqs.ListQueues(ctx, &sqs.ListQueuesInput{MaxResults: aws.Int32(1)}); err != nil {
+		c.logger.Errorf("health check failed: %v", err)
+
+		res.Details["error"] = err.Error()
+
+		return res
+	}
+
+	res.Status = datasource.StatusUp
+
+	return res
+}
+
+// Close stops extending the visibility of the received messages, which are redelivered once their visibility
+// timeout expires.
+func (c *Client) Close() error {
+	c.mu.Lock()
+
+	c.closed = true
+
+	messages := make([]*message, 0, len(c.messages))
+	for m := range c.messages {
+		messages = append(messages, m)
+	}
+
+	c.mu.Unlock()
+
+	for _, m := range messages {
+		m.stop()
+	}
+
+	return nil
+}
+
+// usable returns an error when the client is not connected, or is closed.
+func (c *Client) usable() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch {
+	case c.closed:
+		return errClientClosed
+	case c.sqs == nil || c.sns == nil:
+		return errClientNotConnected
+	default:
+		return nil
+	}
+}
+
+func isFIFO(topic string) bool {
+	return strings.HasSuffix(topic, fifoSuffix)
+}
+
+// messageKey returns the key of the message, or its message group in a FIFO queue.
+func messageKey(msg *sqsTypes.Message) string {
+	if attr, ok := msg.MessageAttributes[KeyAttribute]; ok {
+		return aws.ToString(attr.StringValue)
+	}
+
+	return msg.Attributes[string(sqsTypes.MessageSystemAttributeNameMessageGroupId)]
+}
+
+func messageHeaders(msg *sqsTypes.Message) map[string]string {
+	if len(msg.MessageAttributes) == 0 {
+		return nil
+	}
+
+	headers := make(map[string]string, len(msg.MessageAttributes))
+
+	for k, v := range msg.MessageAttributes {
+		if k == KeyAttribute || v.StringValue == nil {
+			continue
+		}
+
+		headers[k] = *v.StringValue
+	}
+
+	return headers
+}
@@ -0,0 +1,387 @@
+package sqs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqsTypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+
+	"gofr.dev/pkg/gofr/datasource"
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+	"gofr.dev/pkg/gofr/logging"
+)
+
+const (
+	testTopicARN = "arn:aws:sns:us-east-1:000000000000:orders"
+	testQueueURL = "http://localhost:4566/000000000000/orders-service-orders"
+	testQueueARN = "arn:aws:sqs:us-east-1:000000000000:orders-service-orders"
+)
+
+var errAWS = errors.New("aws error")
+
+func newTestClient(t *testing.T, conf Config) (*Client, *MockSQS, *MockSNS) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+
+	metrics := NewMockMetrics(ctrl)
+	metrics.EXPECT().IncrementCounter(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	sqsClient, snsClient := NewMockSQS(ctrl), NewMockSNS(ctrl)
+
+	client := New(conf)
+	client.UseLogger(logging.NewMockLogger(logging.ERROR))
+	client.UseMetrics(metrics)
+	client.sqs, client.sns = sqsClient, snsClient
+
+	t.Cleanup(func() { _ = client.Close() })
+
+	return client, sqsClient, snsClient
+}
+
+func stringAttribute(v string) sqsTypes.MessageAttributeValue {
+	return sqsTypes.MessageAttributeValue{DataType: aws.String("String"), StringValue: aws.String(v)}
+}
+
+func TestClient_Publish(t *testing.T) {
+	client, _, snsClient := newTestClient(t, Config{})
+
+	snsClient.EXPECT().CreateTopic(gomock.Any(), &sns.CreateTopicInput{Name: aws.String("orders")}).
+		Return(&sns.CreateTopicOutput{TopicArn: aws.String(testTopicARN)}, nil)
+
+	snsClient.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, input *sns.PublishInput, _ ...func(*sns.Options)) (*sns.PublishOutput, error) {
+			assert.Equal(t, testTopicARN, aws.ToString(input.TopicArn))
+			assert.JSONEq(t, `{"id":1}`, aws.ToString(input.Message))
+			assert.Equal(t, "gofr", aws.ToString(input.MessageAttributes["tenant"].StringValue))
+			assert.Equal(t, "application/json", aws.ToString(input.MessageAttributes[pubsub.ContentTypeHeader].StringValue))
+			assert.Equal(t, "order-1", aws.ToString(input.MessageAttributes[KeyAttribute].StringValue))
+			assert.Nil(t, input.MessageGroupId, "message group set for a standard topic")
+
+			return &sns.PublishOutput{}, nil
+		}).Times(2)
+
+	options := pubsub.PublishOptions{
+		Headers:     map[string]string{"tenant": "gofr"},
+		Key:         "order-1",
+		ContentType: "application/json",
+	}
+
+	// the ARN of the topic is resolved once.
+	require.NoError(t, client.PublishWithOptions(t.Context(), "orders", []byte(`{"id":1}`), options))
+	require.NoError(t, client.PublishWithOptions(t.Context(), "orders", []byte(`{"id":1}`), options))
+}
+
+func TestClient_PublishFIFO(t *testing.T) {
+	client, _, snsClient := newTestClient(t, Config{})
+
+	snsClient.EXPECT().CreateTopic(gomock.Any(), &sns.CreateTopicInput{
+		Name:       aws.String("orders.fifo"),
+		Attributes: map[string]string{"FifoTopic": "true"},
+	}).Return(&sns.CreateTopicOutput{TopicArn: aws.String(testTopicARN + ".fifo")}, nil)
+
+	var groups, deduplicationIDs []string
+
+	snsClient.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, input *sns.PublishInput, _ ...func(*sns.Options)) (*sns.PublishOutput, error) {
+			groups = append(groups, aws.ToString(input.MessageGroupId))
+			deduplicationIDs = append(deduplicationIDs, aws.ToString(input.MessageDeduplicationId))
+
+			return &sns.PublishOutput{}, nil
+		}).Times(2)
+
+	require.NoError(t, client.PublishWithOptions(t.Context(), "orders.fifo", []byte("1"), pubsub.PublishOptions{Key: "order-1"}))
+	require.NoError(t, client.Publish(t.Context(), "orders.fifo", []byte("1")))
+
+	assert.Equal(t, []string{"order-1", DefaultMessageGroupID}, groups)
+	assert.NotEmpty(t, deduplicationIDs[0])
+	assert.NotEqual(t, deduplicationIDs[0], deduplicationIDs[1], "the messages are deduplicated")
+}
+
+func TestClient_PublishErrors(t *testing.T) {
+	client, _, snsClient := newTestClient(t, Config{})
+
+	snsClient.EXPECT().CreateTopic(gomock.Any(), gomock.Any()).Return(nil, errAWS)
+	require.ErrorIs(t, client.Publish(t.Context(), "orders", []byte("1")), errAWS)
+
+	snsClient.EXPECT().CreateTopic(gomock.Any(), gomock.Any()).Return(&sns.CreateTopicOutput{TopicArn: aws.String(testTopicARN)}, nil)
+	snsClient.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil, errAWS)
+	require.ErrorIs(t, client.Publish(t.Context(), "orders", []byte("1")), errAWS)
+
+	require.ErrorIs(t, client.Publish(t.Context(), "", []byte("1")), errEmptyTopicName)
+
+	require.NoError(t, client.Close())
+	require.ErrorIs(t, client.Publish(t.Context(), "orders", []byte("1")), errClientClosed)
+}
+
+// expectCreateQueue expects the queue of the orders topic to be created and subscribed to the topic.
+func expectCreateQueue(sqsClient *MockSQS, snsClient *MockSNS) {
+	sqsClient.EXPECT().GetQueueUrl(gomock.Any(), &sqs.GetQueueUrlInput{QueueName: aws.String("orders-service-orders")}).
+		Return(nil, &sqsTypes.QueueDoesNotExist{})
+	snsClient.EXPECT().CreateTopic(gomock.Any(), gomock.Any()).Return(&sns.CreateTopicOutput{TopicArn: aws.String(testTopicARN)}, nil)
+	sqsClient.EXPECT().CreateQueue(gomock.Any(), &sqs.CreateQueueInput{
+		QueueName:  aws.String("orders-service-orders"),
+		Attributes: map[string]string{"VisibilityTimeout": "30"},
+	}).Return(&sqs.CreateQueueOutput{QueueUrl: aws.String(testQueueURL)}, nil)
+	sqsClient.EXPECT().GetQueueAttributes(gomock.Any(), gomock.Any()).
+		Return(&sqs.GetQueueAttributesOutput{Attributes: map[string]string{"QueueArn": testQueueARN}}, nil)
+	sqsClient.EXPECT().SetQueueAttributes(gomock.Any(), &sqs.SetQueueAttributesInput{
+		QueueUrl:   aws.String(testQueueURL),
+		Attributes: map[string]string{"Policy": queuePolicy(testQueueARN, testTopicARN)},
+	}).Return(&sqs.SetQueueAttributesOutput{}, nil)
+	snsClient.EXPECT().Subscribe(gomock.Any(), &sns.SubscribeInput{
+		TopicArn:   aws.String(testTopicARN),
+		Protocol:   aws.String("sqs"),
+		Endpoint:   aws.String(testQueueARN),
+		Attributes: map[string]string{"RawMessageDelivery": "true"},
+	}).Return(&sns.SubscribeOutput{}, nil)
+}
+
+func TestClient_Subscribe(t *testing.T) {
+	client, sqsClient, snsClient := newTestClient(t, Config{ConsumerGroupID: "orders-service"})
+
+	expectCreateQueue(sqsClient, snsClient)
+
+	sqsClient.EXPECT().ReceiveMessage(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, input *sqs.ReceiveMessageInput, _ ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error) {
+			assert.Equal(t, testQueueURL, aws.ToString(input.QueueUrl))
+			assert.Equal(t, int32(10), input.MaxNumberOfMessages)
+			assert.Equal(t, int32(20), input.WaitTimeSeconds)
+			assert.Equal(t, int32(30), input.VisibilityTimeout)
+
+			return &sqs.ReceiveMessageOutput{Messages: []sqsTypes.Message{
+				{
+					MessageId:     aws.String("1"),
+					ReceiptHandle: aws.String("receipt-1"),
+					Body:          aws.String(`{"id":1}`),
+					MessageAttributes: map[string]sqsTypes.MessageAttributeValue{
+						"tenant":     stringAttribute("gofr"),
+						KeyAttribute: stringAttribute("order-1"),
+					},
+				},
+				{
+					MessageId:     aws.String("2"),
+					ReceiptHandle: aws.String("receipt-2"),
+					Body:          aws.String(`{"id":2}`),
+					Attributes:    map[string]string{"MessageGroupId": "order-2"},
+				},
+			}}, nil
+		})
+
+	msg, err := client.Subscribe(t.Context(), "orders")
+	require.NoError(t, err)
+
+	assert.Equal(t, "orders", msg.Topic)
+	assert.Equal(t, []byte(`{"id":1}`), msg.Value)
+	assert.Equal(t, "order-1", msg.Key)
+	assert.Equal(t, map[string]string{"tenant": "gofr"}, msg.Headers())
+
+	sqsClient.EXPECT().DeleteMessage(gomock.Any(), &sqs.DeleteMessageInput{
+		QueueUrl:      aws.String(testQueueURL),
+		ReceiptHandle: aws.String("receipt-1"),
+	}).Return(&sqs.DeleteMessageOutput{}, nil)
+
+	msg.Commit()
+
+	// the second message is returned from the received messages.
+	msg, err = client.Subscribe(t.Context(), "orders")
+	require.NoError(t, err)
+	assert.Equal(t, []byte(`{"id":2}`), msg.Value)
+	assert.Equal(t, "order-2", msg.Key, "the message group is not the key of the message")
+
+	msg.Committer.(pubsub.Rejecter).Reject()
+
+	assert.Empty(t, client.messages, "the committed and rejected messages are still tracked")
+}
+
+func TestClient_SubscribeWaitsForMessage(t *testing.T) {
+	client, sqsClient, _ := newTestClient(t, Config{})
+
+	sqsClient.EXPECT().GetQueueUrl(gomock.Any(), gomock.Any()).Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String(testQueueURL)}, nil)
+
+	gomock.InOrder(
+		sqsClient.EXPECT().ReceiveMessage(gomock.Any(), gomock.Any()).Return(&sqs.ReceiveMessageOutput{}, nil),
+		sqsClient.EXPECT().ReceiveMessage(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, _ *sqs.ReceiveMessageInput, _ ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error) {
+				<-ctx.Done()
+
+				return nil, ctx.Err()
+			}),
+	)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := client.Subscribe(ctx, "orders")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	_, err = client.Subscribe(t.Context(), "")
+	require.ErrorIs(t, err, errEmptyTopicName)
+}
+
+func TestClient_SubscribeErrors(t *testing.T) {
+	client, sqsClient, _ := newTestClient(t, Config{})
+
+	sqsClient.EXPECT().GetQueueUrl(gomock.Any(), gomock.Any()).Return(nil, errAWS)
+
+	_, err := client.Subscribe(t.Context(), "orders")
+	require.ErrorIs(t, err, errAWS)
+
+	sqsClient.EXPECT().GetQueueUrl(gomock.Any(), gomock.Any()).Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String(testQueueURL)}, nil)
+	sqsClient.EXPECT().ReceiveMessage(gomock.Any(), gomock.Any()).Return(nil, errAWS)
+
+	_, err = client.Subscribe(t.Context(), "orders")
+	require.ErrorIs(t, err, errAWS)
+
+	require.NoError(t, client.Close())
+
+	_, err = client.Subscribe(t.Context(), "orders")
+	require.ErrorIs(t, err, errClientClosed)
+}
+
+func TestMessage_ExtendVisibility(t *testing.T) {
+	client, sqsClient, _ := newTestClient(t, Config{VisibilityTimeout: time.Second})
+
+	extended := make(chan struct{})
+
+	sqsClient.EXPECT().ChangeMessageVisibility(gomock.Any(), &sqs.ChangeMessageVisibilityInput{
+		QueueUrl:          aws.String(testQueueURL),
+		ReceiptHandle:     aws.String("receipt-1"),
+		VisibilityTimeout: 1,
+	}).DoAndReturn(func(context.Context, *sqs.ChangeMessageVisibilityInput, ...func(*sqs.Options)) (
+		*sqs.ChangeMessageVisibilityOutput, error) {
+		close(extended)
+
+		return nil, errAWS
+	})
+
+	m := newMessage(client, testQueueURL, sqsTypes.Message{MessageId: aws.String("1"), ReceiptHandle: aws.String("receipt-1")})
+
+	select {
+	case <-extended:
+	case <-time.After(2 * time.Second):
+		t.Fatal("visibility of the message not extended")
+	}
+
+	m.Reject()
+}
+
+func TestClient_Query(t *testing.T) {
+	client, sqsClient, _ := newTestClient(t, Config{})
+
+	sqsClient.EXPECT().GetQueueUrl(gomock.Any(), &sqs.GetQueueUrlInput{QueueName: aws.String("orders")}).
+		Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String(testQueueURL)}, nil)
+	sqsClient.EXPECT().ReceiveMessage(gomock.Any(), &sqs.ReceiveMessageInput{
+		QueueUrl:            aws.String(testQueueURL),
+		MaxNumberOfMessages: 2,
+	}).Return(&sqs.ReceiveMessageOutput{Messages: []sqsTypes.Message{
+		{Body: aws.String("1"), ReceiptHandle: aws.String("receipt-1")},
+		{Body: aws.String("2"), ReceiptHandle: aws.String("receipt-2")},
+	}}, nil)
+	sqsClient.EXPECT().ChangeMessageVisibility(gomock.Any(), gomock.Any()).Return(&sqs.ChangeMessageVisibilityOutput{}, nil).Times(2)
+
+	result, err := client.Query(t.Context(), "orders", 2)
+	require.NoError(t, err)
+	assert.Equal(t, "1\n2", string(result))
+
+	_, err = client.Query(t.Context(), "")
+	require.ErrorIs(t, err, errEmptyTopicName)
+}
+
+func TestClient_Topics(t *testing.T) {
+	client, sqsClient, snsClient := newTestClient(t, Config{ConsumerGroupID: "orders-service"})
+
+	expectCreateQueue(sqsClient, snsClient)
+
+	require.NoError(t, client.CreateTopic(t.Context(), "orders"))
+
+	sqsClient.EXPECT().GetQueueUrl(gomock.Any(), gomock.Any()).Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String(testQueueURL)}, nil)
+	sqsClient.EXPECT().DeleteQueue(gomock.Any(), &sqs.DeleteQueueInput{QueueUrl: aws.String(testQueueURL)}).
+		Return(&sqs.DeleteQueueOutput{}, nil)
+	snsClient.EXPECT().DeleteTopic(gomock.Any(), &sns.DeleteTopicInput{TopicArn: aws.String(testTopicARN)}).
+		Return(&sns.DeleteTopicOutput{}, nil)
+
+	require.NoError(t, client.DeleteTopic(t.Context(), "orders"))
+	assert.Empty(t, client.topicARNs)
+
+	require.ErrorIs(t, client.CreateTopic(t.Context(), ""), errEmptyTopicName)
+	require.ErrorIs(t, client.DeleteTopic(t.Context(), ""), errEmptyTopicName)
+}
+
+func TestClient_Health(t *testing.T) {
+	client, sqsClient, _ := newTestClient(t, Config{Region: "us-east-1", ConsumerGroupID: "orders-service"})
+
+	sqsClient.EXPECT().ListQueues(gomock.Any(), gomock.Any()).Return(&sqs.ListQueuesOutput{}, nil)
+
+	health := client.Health()
+	assert.Equal(t, datasource.StatusUp, health.Status)
+	assert.Equal(t, map[string]any{"backend": "SQS", "region": "us-east-1", "consumer_group": "orders-service"}, health.Details)
+
+	sqsClient.EXPECT().ListQueues(gomock.Any(), gomock.Any()).Return(nil, errAWS)
+
+	health = client.Health()
+	assert.Equal(t, datasource.StatusDown, health.Status)
+	assert.Equal(t, errAWS.Error(), health.Details["error"])
+}
+
+func TestClient_QueueName(t *testing.T) {
+	client, _, _ := newTestClient(t, Config{ConsumerGroupID: "orders-service"})
+
+	assert.Equal(t, "orders-service-orders", client.queueName("orders"))
+	assert.Equal(t, "orders-service-orders.fifo", client.queueName("orders.fifo"))
+
+	client, _, _ = newTestClient(t, Config{})
+
+	assert.Equal(t, "orders.fifo", client.queueName("orders.fifo"))
+}
+
+func TestNew(t *testing.T) {
+	client := New(Config{Region: "us-east-1", EndPoint: "http://localhost:4566", AccessKeyID: "test", SecretAccessKey: "test"})
+
+	assert.Equal(t, DefaultMessageGroupID, client.config.MessageGroupID)
+	assert.Equal(t, defaultWaitTime, client.config.WaitTime)
+	assert.Equal(t, defaultVisibilityTimeout, client.config.VisibilityTimeout)
+	assert.Equal(t, defaultMaxMessages, client.config.MaxMessages)
+
+	client.UseLogger(logging.NewMockLogger(logging.ERROR))
+	client.UseMetrics(NewMockMetrics(gomock.NewController(t)))
+	client.UseTracer(otel.GetTracerProvider().Tracer("gofr-sqs"))
+	client.Connect()
+
+	assert.NotNil(t, client.sqs)
+	assert.NotNil(t, client.sns)
+}
+
+func TestClient_Connect_NoRegion(t *testing.T) {
+	t.Setenv("AWS_REGION", "")
+	t.Setenv("AWS_DEFAULT_REGION", "")
+	t.Setenv("AWS_CONFIG_FILE", "/nonexistent")
+
+	client := New(Config{})
+	client.UseLogger(logging.NewMockLogger(logging.ERROR))
+	client.Connect()
+
+	assert.Equal(t, datasource.StatusDown, client.Health().Status)
+
+	_, err := client.Subscribe(t.Context(), "orders")
+	require.ErrorIs(t, err, errClientNotConnected)
+}
+
+func TestMessageHeaders(t *testing.T) {
+	msg := &sqsTypes.Message{MessageAttributes: map[string]sqsTypes.MessageAttributeValue{
+		"tenant": stringAttribute("gofr"),
+		"binary": {DataType: aws.String("Binary"), BinaryValue: []byte("1")},
+	}}
+
+	assert.Equal(t, map[string]string{"tenant": "gofr"}, messageHeaders(msg))
+	assert.Nil(t, messageHeaders(&sqsTypes.Message{}))
+}
@@ -0,0 +1,242 @@
+package sqs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqsTypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+)
+
+// CreateTopic creates the SNS topic, and the queue of the consumer group subscribed to it.
+func (c *Client) CreateTopic(ctx context.Context, name string) error {
+	if name == "" {
+		return errEmptyTopicName
+	}
+
+	if err := c.usable(); err != nil {
+		return err
+	}
+
+	_, err := c.queue(ctx, name)
+
+	return err
+}
+
+// DeleteTopic deletes the queue of the consumer group for the topic, and the SNS topic.
+func (c *Client) DeleteTopic(ctx context.Context, name string) error {
+	if name == "" {
+		return errEmptyTopicName
+	}
+
+	if err := c.usable(); err != nil {
+		return err
+	}
+
+	out, err := c.sqs.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{QueueName: aws.String(c.queueName(name))})
+
+	var notFound *sqsTypes.QueueDoesNotExist
+
+	switch {
+	case errors.As(err, &notFound):
+	case err != nil:
+		return err
+	default:
+		if _, err = c.sqs.DeleteQueue(ctx, &sqs.DeleteQueueInput{QueueUrl: out.QueueUrl}); err != nil {
+			return err
+		}
+	}
+
+	arn, err := c.topicARN(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if _, err = c.sns.DeleteTopic(ctx, &sns.DeleteTopicInput{TopicArn: aws.String(arn)}); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	delete(c.topicARNs, name)
+	delete(c.queues, name)
+	c.mu.Unlock()
+
+	return nil
+}
+
+// topicARN returns the ARN of the SNS topic, creating the topic if it does not exist.
+func (c *Client) topicARN(ctx context.Context, topic string) (string, error) {
+	c.mu.Lock()
+	arn, ok := c.topicARNs[topic]
+	c.mu.Unlock()
+
+	if ok {
+		return arn, nil
+	}
+
+	input := &sns.CreateTopicInput{Name: aws.String(topic)}
+
+	if isFIFO(topic) {
+		input.Attributes = map[string]string{"FifoTopic": "true"}
+	}
+
+	// CreateTopic returns the ARN of the topic when it exists.
+	out, err := c.sns.CreateTopic(ctx, input)
+	if err != nil {
+		return "", err
+	}
+
+	if out.TopicArn == nil {
+		return "", errTopicNotFound
+	}
+
+	c.mu.Lock()
+	c.topicARNs[topic] = *out.TopicArn
+	c.mu.Unlock()
+
+	return *out.TopicArn, nil
+}
+
+// queue returns the queue of the topic for the consumer group, creating it if it does not exist.
+func (c *Client) queue(ctx context.Context, topic string) (*queue, error) {
+	c.mu.Lock()
+	q, ok := c.queues[topic]
+	c.mu.Unlock()
+
+	if ok {
+		return q, nil
+	}
+
+	url, err := c.queueURL(ctx, topic)
+
+	var notFound *sqsTypes.QueueDoesNotExist
+	if errors.As(err, &notFound) {
+		url, err = c.createQueue(ctx, topic)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if q, ok = c.queues[topic]; !ok {
+		q = &queue{url: url}
+		c.queues[topic] = q
+	}
+
+	return q, nil
+}
+
+func (c *Client) queueURL(ctx context.Context, topic string) (string, error) {
+	c.mu.Lock()
+	q, ok := c.queues[topic]
+	c.mu.Unlock()
+
+	if ok {
+		return q.url, nil
+	}
+
+	out, err := c.sqs.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{QueueName: aws.String(c.queueName(topic))})
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(out.QueueUrl), nil
+}
+
+// createQueue creates the queue of the topic for the consumer group, allowing the SNS topic to send the messages
+// to it, and subscribes it to the topic with raw message delivery.
+func (c *Client) createQueue(ctx context.Context, topic string) (string, error) {
+	topicARN, err := c.topicARN(ctx, topic)
+	if err != nil {
+		return "", err
+	}
+
+	attributes := map[string]string{
+		string(sqsTypes.QueueAttributeNameVisibilityTimeout): strconv.Itoa(int(c.visibilityTimeout())),
+	}
+
+	if isFIFO(topic) {
+		attributes[string(sqsTypes.QueueAttributeNameFifoQueue)] = "true"
+	}
+
+	out, err := c.sqs.CreateQueue(ctx, &sqs.CreateQueueInput{QueueName: aws.String(c.queueName(topic)), Attributes: attributes})
+	if err != nil {
+		return "", err
+	}
+
+	attrs, err := c.sqs.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl:       out.QueueUrl,
+		AttributeNames: []sqsTypes.QueueAttributeName{sqsTypes.QueueAttributeNameQueueArn},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	queueARN, ok := attrs.Attributes[string(sqsTypes.QueueAttributeNameQueueArn)]
+	if !ok {
+		return "", errQueueNotFound
+	}
+
+	_, err = c.sqs.SetQueueAttributes(ctx, &sqs.SetQueueAttributesInput{
+		QueueUrl:   out.QueueUrl,
+		Attributes: map[string]string{string(sqsTypes.QueueAttributeNamePolicy): queuePolicy(queueARN, topicARN)},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	_, err = c.sns.Subscribe(ctx, &sns.SubscribeInput{
+		TopicArn:   aws.String(topicARN),
+		Protocol:   aws.String("sqs"),
+		Endpoint:   aws.String(queueARN),
+		Attributes: map[string]string{"RawMessageDelivery": "true"},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	c.logger.Debugf("created SQS queue %s subscribed to SNS topic %s", c.queueName(topic), topic)
+
+	return aws.ToString(out.QueueUrl), nil
+}
+
+// queueName returns the name of the queue of the topic for the consumer group, a FIFO queue for a FIFO topic.
+func (c *Client) queueName(topic string) string {
+	if c.config.ConsumerGroupID == "" {
+		return topic
+	}
+
+	name := c.config.ConsumerGroupID + "-" + strings.TrimSuffix(topic, fifoSuffix)
+
+	if isFIFO(topic) {
+		name += fifoSuffix
+	}
+
+	return name
+}
+
+// queuePolicy returns the policy of the queue allowing the SNS topic to send the messages to it.
+func queuePolicy(queueARN, topicARN string) string {
+	policy := map[string]any{
+		"Version": "2012-10-17",
+		"Statement": []map[string]any{{
+			"Effect":    "Allow",
+			"Principal": map[string]string{"Service": "sns.amazonaws.com"},
+			"Action":    "sqs:SendMessage",
+			"Resource":  queueARN,
+			"Condition": map[string]any{"ArnEquals": map[string]string{"aws:SourceArn": topicARN}},
+		}},
+	}
+
+	b, _ := json.Marshal(policy)
+
+	return string(b)
+}
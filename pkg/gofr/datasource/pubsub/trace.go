@@ -0,0 +1,35 @@
+package pubsub
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// InjectTraceContext returns the headers with the trace context of ctx added, e.g. the W3C traceparent header,
+// so that the span of the subscriber handling the message continues the trace of the publisher.
+// The headers passed are not modified.
+func InjectTraceContext(ctx context.Context, headers map[string]string) map[string]string {
+	carrier := propagation.MapCarrier{}
+
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+	if len(carrier) == 0 {
+		return headers
+	}
+
+	for k, v := range headers {
+		if _, ok := carrier[k]; !ok {
+			carrier[k] = v
+		}
+	}
+
+	return carrier
+}
+
+// ExtractTraceContext returns ctx with the trace context of the publisher of a message, injected in its headers
+// by InjectTraceContext.
+func ExtractTraceContext(ctx context.Context, headers map[string]string) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(headers))
+}
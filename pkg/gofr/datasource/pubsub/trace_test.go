@@ -0,0 +1,37 @@
+package pubsub
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTraceContext(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	t.Cleanup(func() { otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator()) })
+
+	ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(t.Context(), "publish")
+	defer span.End()
+
+	headers := map[string]string{"tenant": "gofr"}
+
+	injected := InjectTraceContext(ctx, headers)
+
+	assert.Equal(t, map[string]string{"tenant": "gofr"}, headers, "the headers passed should not be modified")
+	assert.Equal(t, "gofr", injected["tenant"])
+	assert.Contains(t, injected["traceparent"], span.SpanContext().TraceID().String())
+
+	extracted := trace.SpanContextFromContext(ExtractTraceContext(t.Context(), injected))
+
+	assert.True(t, extracted.IsRemote())
+	assert.Equal(t, span.SpanContext().TraceID(), extracted.TraceID())
+	assert.Equal(t, span.SpanContext().SpanID(), extracted.SpanID())
+
+	// no trace context is injected without a span.
+	assert.Equal(t, headers, InjectTraceContext(t.Context(), headers))
+}
@@ -147,6 +147,16 @@ func (t *Tx) Query(query string, args ...any) (*sql.Rows, error) {
 	return t.Tx.QueryContext(context.Background(), query, args...)
 }
 
+func (t *Tx) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	defer t.sendOperationStats(time.Now(), "TxQueryContext", query, args...)
+	return t.Tx.QueryContext(ctx, query, args...)
+}
+
+// Dialect returns the dialect of the database of the transaction.
+func (t *Tx) Dialect() string {
+	return t.config.Dialect
+}
+
 func (t *Tx) QueryRow(query string, args ...any) *sql.Row {
 	defer t.sendOperationStats(time.Now(), "TxQueryRow", query, args...)
 	return t.Tx.QueryRowContext(context.Background(), query, args...)
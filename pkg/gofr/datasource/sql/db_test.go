@@ -754,6 +754,46 @@ func TestTx_Query(t *testing.T) {
 	assert.Contains(t, out, "Query SELECT 1")
 }
 
+func TestTx_QueryContext(t *testing.T) {
+	var (
+		rows *sql.Rows
+		err  error
+	)
+
+	out := testutil.StdoutOutputForFunc(func() {
+		db, mock := getDB(t, logging.DEBUG)
+		ctrl := gomock.NewController(t)
+		mockMetrics := NewMockMetrics(ctrl)
+
+		db.metrics = mockMetrics
+
+		tx := getTransaction(db, mock)
+
+		defer db.DB.Close()
+
+		mock.ExpectQuery("SELECT 1").
+			WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow("1"))
+		mockMetrics.EXPECT().RecordHistogram(gomock.Any(), "app_sql_stats",
+			gomock.Any(), "hostname", gomock.Any(), "database", gomock.Any(), "type", "SELECT")
+
+		rows, err = tx.QueryContext(t.Context(), "SELECT 1")
+		require.NoError(t, err)
+		assert.NotNil(t, rows)
+		require.NoError(t, rows.Err())
+	})
+
+	assert.Contains(t, out, "TxQueryContext")
+}
+
+func TestTx_Dialect(t *testing.T) {
+	db, mock, _ := NewSQLMocksWithConfig(t, &DBConfig{Dialect: "postgres"})
+	defer db.DB.Close()
+
+	tx := getTransaction(db, mock)
+
+	assert.Equal(t, "postgres", tx.Dialect())
+}
+
 func TestTx_QueryError(t *testing.T) {
 	var (
 		rows *sql.Rows
@@ -0,0 +1,84 @@
+// Package dedup provides the stores of the keys of the processed messages, with which the subscriptions registered
+// with gofr.WithDeduplication skip the messages delivered more than once by the brokers delivering at least once,
+// e.g. Kafka and NATS JetStream.
+package dedup
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Store records the keys of the processed messages for a time to live.
+type Store interface {
+	// Processed reports whether the key is recorded as processed, and its time to live is not over.
+	Processed(ctx context.Context, key string) (bool, error)
+	// MarkProcessed records the key as processed for the time to live.
+	MarkProcessed(ctx context.Context, key string, ttl time.Duration) error
+}
+
+// redisKeyPrefix is the prefix of the keys of the processed messages in Redis and in the key-value stores.
+const redisKeyPrefix = "gofr:dedup:"
+
+// RedisStore records the processed messages in Redis, as keys expiring after their time to live.
+type RedisStore struct {
+	client redis.Cmdable
+}
+
+// NewRedisStore returns the store recording the processed messages in Redis, e.g. the Redis datasource of the app.
+func NewRedisStore(client redis.Cmdable) *RedisStore {
+	return &RedisStore{client: client}
+}
+
+func (s *RedisStore) Processed(ctx context.Context, key string) (bool, error) {
+	n, err := s.client.Exists(ctx, redisKeyPrefix+key).Result()
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+func (s *RedisStore) MarkProcessed(ctx context.Context, key string, ttl time.Duration) error {
+	return s.client.Set(ctx, redisKeyPrefix+key, 1, ttl).Err()
+}
+
+// KeyValueStore is the key-value store in which the processed messages are recorded, e.g. the KVStore of the app.
+type KeyValueStore interface {
+	Get(ctx context.Context, key string) (string, error)
+	Set(ctx context.Context, key, value string) error
+	Delete(ctx context.Context, key string) error
+}
+
+// KVStore records the processed messages in a key-value store, with the time at which they expire as their values,
+// as the key-value stores have no time to live. The expired keys are deleted when they are read.
+type KVStore struct {
+	store KeyValueStore
+}
+
+// NewKVStore returns the store recording the processed messages in the key-value store.
+func NewKVStore(store KeyValueStore) *KVStore {
+	return &KVStore{store: store}
+}
+
+// Processed reports whether the key is recorded as processed. As the key-value stores return an error for a missing
+// key, a key which cannot be read is not processed.
+func (s *KVStore) Processed(ctx context.Context, key string) (bool, error) {
+	value, err := s.store.Get(ctx, redisKeyPrefix+key)
+	if err != nil {
+		return false, nil
+	}
+
+	expiresAt, err := strconv.ParseInt(value, 10, 64)
+	if err == nil && time.Now().UnixMilli() < expiresAt {
+		return true, nil
+	}
+
+	return false, s.store.Delete(ctx, redisKeyPrefix+key)
+}
+
+func (s *KVStore) MarkProcessed(ctx context.Context, key string, ttl time.Duration) error {
+	return s.store.Set(ctx, redisKeyPrefix+key, strconv.FormatInt(time.Now().Add(ttl).UnixMilli(), 10))
+}
@@ -0,0 +1,96 @@
+package dedup
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errNotFound = errors.New("key not found")
+
+func TestRedisStore(t *testing.T) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+
+	store := NewRedisStore(client)
+
+	processed, err := store.Processed(t.Context(), "orders:1")
+	require.NoError(t, err)
+	assert.False(t, processed)
+
+	require.NoError(t, store.MarkProcessed(t.Context(), "orders:1", time.Minute))
+
+	processed, err = store.Processed(t.Context(), "orders:1")
+	require.NoError(t, err)
+	assert.True(t, processed)
+	assert.Equal(t, time.Minute, server.TTL("gofr:dedup:orders:1"))
+
+	server.FastForward(time.Minute)
+
+	processed, err = store.Processed(t.Context(), "orders:1")
+	require.NoError(t, err)
+	assert.False(t, processed, "the key is processed after its time to live")
+
+	server.Close()
+
+	_, err = store.Processed(t.Context(), "orders:1")
+	require.Error(t, err)
+}
+
+// memoryStore is a key-value store in memory, returning errNotFound for the missing keys.
+type memoryStore map[string]string
+
+func (m memoryStore) Get(_ context.Context, key string) (string, error) {
+	v, ok := m[key]
+	if !ok {
+		return "", errNotFound
+	}
+
+	return v, nil
+}
+
+func (m memoryStore) Set(_ context.Context, key, value string) error {
+	m[key] = value
+
+	return nil
+}
+
+func (m memoryStore) Delete(_ context.Context, key string) error {
+	delete(m, key)
+
+	return nil
+}
+
+func TestKVStore(t *testing.T) {
+	kv := memoryStore{}
+	store := NewKVStore(kv)
+
+	processed, err := store.Processed(t.Context(), "orders:1")
+	require.NoError(t, err)
+	assert.False(t, processed)
+
+	require.NoError(t, store.MarkProcessed(t.Context(), "orders:1", time.Minute))
+
+	processed, err = store.Processed(t.Context(), "orders:1")
+	require.NoError(t, err)
+	assert.True(t, processed)
+
+	require.NoError(t, store.MarkProcessed(t.Context(), "orders:2", -time.Minute))
+
+	processed, err = store.Processed(t.Context(), "orders:2")
+	require.NoError(t, err)
+	assert.False(t, processed, "the key is processed after its time to live")
+	assert.NotContains(t, kv, "gofr:dedup:orders:2", "the expired key is not deleted")
+
+	kv["gofr:dedup:orders:3"] = "invalid"
+
+	processed, err = store.Processed(t.Context(), "orders:3")
+	require.NoError(t, err)
+	assert.False(t, processed)
+}
@@ -0,0 +1,52 @@
+package dedup
+
+import (
+	"errors"
+	"fmt"
+
+	"gofr.dev/pkg/gofr/migration"
+)
+
+var errUnknownDialect = errors.New("unable to find the dialect of the SQL datasource of the migration")
+
+const (
+	createTableMySQL = `CREATE TABLE IF NOT EXISTS %s (
+    message_key VARCHAR(512) NOT NULL PRIMARY KEY,
+    expires_at BIGINT NOT NULL,
+    INDEX %s_expires (expires_at)
+);`
+
+	createTable = `CREATE TABLE IF NOT EXISTS %s (
+    message_key VARCHAR(512) NOT NULL PRIMARY KEY,
+    expires_at BIGINT NOT NULL
+);`
+
+	createIndex = `CREATE INDEX IF NOT EXISTS %s_expires ON %s (expires_at);`
+)
+
+// CreateTable creates the table of the processed messages and its index, if they do not exist.
+// It is a migration.MigrateFunc, to be registered as a migration of the app:
+//
+//	app.Migrate(map[int64]migration.Migrate{
+//		20240101000000: {UP: dedup.CreateTable},
+//	})
+func CreateTable(d migration.Datasource) error {
+	db, ok := d.SQL.(interface{ Dialect() string })
+	if !ok {
+		return errUnknownDialect
+	}
+
+	queries := []string{fmt.Sprintf(createTable, TableName), fmt.Sprintf(createIndex, TableName, TableName)}
+
+	if db.Dialect() == "mysql" {
+		queries = []string{fmt.Sprintf(createTableMySQL, TableName, TableName)}
+	}
+
+	for _, query := range queries {
+		if _, err := d.SQL.Exec(query); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
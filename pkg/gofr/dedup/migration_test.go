@@ -0,0 +1,47 @@
+package dedup
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	gofrSql "gofr.dev/pkg/gofr/datasource/sql"
+	"gofr.dev/pkg/gofr/migration"
+)
+
+func TestCreateTable(t *testing.T) {
+	testCases := []struct {
+		dialect string
+		queries []string
+	}{
+		{dialect: "mysql", queries: []string{fmt.Sprintf(createTableMySQL, TableName, TableName)}},
+		{dialect: "postgres", queries: []string{fmt.Sprintf(createTable, TableName),
+			"CREATE INDEX IF NOT EXISTS gofr_processed_messages_expires ON gofr_processed_messages (expires_at);"}},
+		{dialect: "sqlite", queries: []string{fmt.Sprintf(createTable, TableName),
+			"CREATE INDEX IF NOT EXISTS gofr_processed_messages_expires ON gofr_processed_messages (expires_at);"}},
+	}
+
+	for i, tc := range testCases {
+		db, mock, _ := gofrSql.NewSQLMocksWithConfig(t, &gofrSql.DBConfig{Dialect: tc.dialect})
+
+		for _, query := range tc.queries {
+			mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 0))
+		}
+
+		err := CreateTable(migration.Datasource{SQL: db})
+
+		require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.dialect)
+		require.NoError(t, mock.ExpectationsWereMet(), "TEST[%d], Failed.\n%s", i, tc.dialect)
+	}
+}
+
+func TestCreateTable_Errors(t *testing.T) {
+	db, mock, _ := gofrSql.NewSQLMocksWithConfig(t, &gofrSql.DBConfig{Dialect: "mysql"})
+
+	mock.ExpectExec(fmt.Sprintf(createTableMySQL, TableName, TableName)).WillReturnError(errDB)
+
+	require.ErrorIs(t, CreateTable(migration.Datasource{SQL: db}), errDB)
+	require.ErrorIs(t, CreateTable(migration.Datasource{}), errUnknownDialect)
+}
@@ -0,0 +1,88 @@
+package dedup
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// TableName is the name of the table of the processed messages, created by CreateTable.
+const TableName = "gofr_processed_messages"
+
+// DB is the SQL database in which the processed messages are recorded, e.g. the SQL datasource of the app.
+type DB interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	Dialect() string
+}
+
+// SQLStore records the processed messages in the table created by CreateTable, with the time at which they expire.
+// The expired messages are not processed, and are deleted by DeleteExpired.
+type SQLStore struct {
+	db DB
+}
+
+// NewSQLStore returns the store recording the processed messages in the SQL database.
+func NewSQLStore(db DB) *SQLStore {
+	return &SQLStore{db: db}
+}
+
+func (s *SQLStore) Processed(ctx context.Context, key string) (bool, error) {
+	query := fmt.Sprintf("SELECT 1 FROM %s WHERE message_key = %s AND expires_at > %s", TableName,
+		bindVar(s.db.Dialect(), 1), bindVar(s.db.Dialect(), 2))
+
+	var found int
+
+	err := s.db.QueryRowContext(ctx, query, key, time.Now().UnixMilli()).Scan(&found)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *SQLStore) MarkProcessed(ctx context.Context, key string, ttl time.Duration) error {
+	dialect := s.db.Dialect()
+
+	query := fmt.Sprintf("INSERT INTO %s (message_key, expires_at) VALUES (%s, %s) ", TableName,
+		bindVar(dialect, 1), bindVar(dialect, 2))
+
+	if dialect == "mysql" {
+		query += "ON DUPLICATE KEY UPDATE expires_at = VALUES(expires_at)"
+	} else {
+		query += "ON CONFLICT (message_key) DO UPDATE SET expires_at = excluded.expires_at"
+	}
+
+	_, err := s.db.ExecContext(ctx, query, key, time.Now().Add(ttl).UnixMilli())
+
+	return err
+}
+
+// DeleteExpired deletes the processed messages whose time to live is over, returning the number of deleted messages.
+// It is to be called periodically, e.g. by a cron job of the app.
+func (s *SQLStore) DeleteExpired(ctx context.Context) (int64, error) {
+	query := fmt.Sprintf("DELETE FROM %s WHERE expires_at <= %s", TableName, bindVar(s.db.Dialect(), 1))
+
+	result, err := s.db.ExecContext(ctx, query, time.Now().UnixMilli())
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// bindVar returns the bind variable of the dialect at the position, $1, $2, ... or ?.
+func bindVar(dialect string, position int) string {
+	switch dialect {
+	case "postgres", "supabase", "cockroachdb":
+		return fmt.Sprintf("$%d", position)
+	default:
+		return "?"
+	}
+}
@@ -0,0 +1,93 @@
+package dedup
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gofrSql "gofr.dev/pkg/gofr/datasource/sql"
+)
+
+var errDB = errors.New("db error")
+
+func TestSQLStore_Processed(t *testing.T) {
+	testCases := []struct {
+		dialect string
+		query   string
+	}{
+		{dialect: "mysql", query: "SELECT 1 FROM gofr_processed_messages WHERE message_key = ? AND expires_at > ?"},
+		{dialect: "postgres", query: "SELECT 1 FROM gofr_processed_messages WHERE message_key = $1 AND expires_at > $2"},
+	}
+
+	for i, tc := range testCases {
+		db, mock, _ := gofrSql.NewSQLMocksWithConfig(t, &gofrSql.DBConfig{Dialect: tc.dialect})
+
+		mock.ExpectQuery(tc.query).WithArgs("orders:1", sqlmock.AnyArg()).WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+		mock.ExpectQuery(tc.query).WithArgs("orders:2", sqlmock.AnyArg()).WillReturnRows(sqlmock.NewRows([]string{"1"}))
+		mock.ExpectQuery(tc.query).WithArgs("orders:3", sqlmock.AnyArg()).WillReturnError(errDB)
+
+		store := NewSQLStore(db)
+
+		processed, err := store.Processed(t.Context(), "orders:1")
+		require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.dialect)
+		assert.True(t, processed, "TEST[%d], Failed.\n%s", i, tc.dialect)
+
+		processed, err = store.Processed(t.Context(), "orders:2")
+		require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.dialect)
+		assert.False(t, processed, "TEST[%d], Failed.\n%s", i, tc.dialect)
+
+		_, err = store.Processed(t.Context(), "orders:3")
+		require.ErrorIs(t, err, errDB, "TEST[%d], Failed.\n%s", i, tc.dialect)
+
+		require.NoError(t, mock.ExpectationsWereMet(), "TEST[%d], Failed.\n%s", i, tc.dialect)
+	}
+}
+
+func TestSQLStore_MarkProcessed(t *testing.T) {
+	testCases := []struct {
+		dialect string
+		query   string
+	}{
+		{dialect: "mysql", query: "INSERT INTO gofr_processed_messages (message_key, expires_at) VALUES (?, ?) " +
+			"ON DUPLICATE KEY UPDATE expires_at = VALUES(expires_at)"},
+		{dialect: "postgres", query: "INSERT INTO gofr_processed_messages (message_key, expires_at) VALUES ($1, $2) " +
+			"ON CONFLICT (message_key) DO UPDATE SET expires_at = excluded.expires_at"},
+		{dialect: "sqlite", query: "INSERT INTO gofr_processed_messages (message_key, expires_at) VALUES (?, ?) " +
+			"ON CONFLICT (message_key) DO UPDATE SET expires_at = excluded.expires_at"},
+	}
+
+	for i, tc := range testCases {
+		db, mock, _ := gofrSql.NewSQLMocksWithConfig(t, &gofrSql.DBConfig{Dialect: tc.dialect})
+
+		mock.ExpectExec(tc.query).WithArgs("orders:1", sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := NewSQLStore(db).MarkProcessed(t.Context(), "orders:1", time.Hour)
+
+		require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.dialect)
+		require.NoError(t, mock.ExpectationsWereMet(), "TEST[%d], Failed.\n%s", i, tc.dialect)
+	}
+}
+
+func TestSQLStore_DeleteExpired(t *testing.T) {
+	db, mock, _ := gofrSql.NewSQLMocksWithConfig(t, &gofrSql.DBConfig{Dialect: "postgres"})
+
+	mock.ExpectExec("DELETE FROM gofr_processed_messages WHERE expires_at <= $1").WithArgs(sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec("DELETE FROM gofr_processed_messages WHERE expires_at <= $1").WithArgs(sqlmock.AnyArg()).
+		WillReturnError(errDB)
+
+	store := NewSQLStore(db)
+
+	deleted, err := store.DeleteExpired(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+
+	_, err = store.DeleteExpired(t.Context())
+	require.ErrorIs(t, err, errDB)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -45,7 +45,7 @@ func New() *App {
 	app.httpServer.staticFiles = make(map[string]string)
 
 	// Add Default routes
-	app.add(http.MethodGet, service.HealthPath, healthHandler)
+	app.add(http.MethodGet, service.HealthPath, app.healthHandler)
 	app.add(http.MethodGet, service.AlivePath, liveHandler)
 	app.add(http.MethodGet, "/favicon.ico", faviconHandler)
 
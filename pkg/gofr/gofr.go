@@ -8,8 +8,10 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	"golang.org/x/sync/errgroup"
@@ -20,6 +22,7 @@ import (
 	"gofr.dev/pkg/gofr/logging"
 	"gofr.dev/pkg/gofr/metrics"
 	"gofr.dev/pkg/gofr/migration"
+	"gofr.dev/pkg/gofr/outbox"
 	"gofr.dev/pkg/gofr/service"
 )
 
@@ -46,6 +49,7 @@ type App struct {
 	httpRegistered bool
 
 	subscriptionManager SubscriptionManager
+	outboxRelay         *outbox.Relay
 	onStartHooks        []func(ctx *Context) error
 }
 
@@ -156,11 +160,11 @@ func (a *App) startSubscriptions(ctx context.Context) error {
 
 	group := errgroup.Group{}
 	// Start subscribers concurrently using go-routines
-	for topic, handler := range a.subscriptionManager.subscriptions {
-		subscriberTopic, subscriberHandler := topic, handler
+	for topic, sub := range a.subscriptionManager.subscriptions {
+		subscriberTopic, subscription := topic, sub
 
 		group.Go(func() error {
-			return a.subscriptionManager.startSubscriber(ctx, subscriberTopic, subscriberHandler)
+			return a.subscriptionManager.startSubscriber(ctx, subscriberTopic, subscription)
 		})
 	}
 
@@ -194,7 +198,50 @@ func (a *App) AddHTTPService(serviceName, serviceAddress string, options ...serv
 		a.container.Debugf("Service already registered Name: %v", serviceName)
 	}
 
-	a.container.Services[serviceName] = service.NewHTTPService(serviceAddress, a.container.Logger, a.container.Metrics(), options...)
+	a.container.Services[serviceName] = service.NewHTTPService(serviceAddress, a.container.Logger, a.container.Metrics(),
+		a.resolveServiceOptions(serviceName, options)...)
+}
+
+// resolveServiceOptions provides the configs and datasources of the app to the options that depend on them.
+func (a *App) resolveServiceOptions(serviceName string, options []service.Options) []service.Options {
+	resolved := make([]service.Options, 0, len(options)+1)
+	hasTransport := false
+
+	for _, o := range options {
+		switch opt := o.(type) {
+		case *service.CacheConfig:
+			if opt.UseRedis {
+				o = opt.WithRedis(a.container.Redis)
+			}
+		case *service.TransportConfig:
+			o = a.transportConfigFromEnv(serviceName, opt)
+			hasTransport = true
+		}
+
+		resolved = append(resolved, o)
+	}
+
+	// the transport can be configured from env even if it is not configured in code.
+	if !hasTransport {
+		if transport := a.transportConfigFromEnv(serviceName, &service.TransportConfig{}); *transport != (service.TransportConfig{}) {
+			resolved = append(resolved, transport)
+		}
+	}
+
+	return resolved
+}
+
+func (a *App) transportConfigFromEnv(serviceName string, transport *service.TransportConfig) *service.TransportConfig {
+	if a.Config == nil {
+		return transport
+	}
+
+	transport, err := transport.WithEnv(a.Config, serviceName)
+	if err != nil {
+		a.container.Errorf("ignoring transport configs of service %v: %v", serviceName, err)
+	}
+
+	return transport
 }
 
 // Metrics returns the metrics manager associated with the App.
@@ -226,11 +273,11 @@ func (a *App) Migrate(migrationsMap map[int64]migration.Migrate) {
 	migration.Run(migrationsMap, a.container)
 }
 
-// Subscribe registers a handler for the given topic.
+// Subscribe registers a handler for the given topic, configured by the options, e.g. WithRetryPolicy.
 //
-// If the subscriber is not initialized in the container, an error is logged and
-// the subscription is not registered.
-func (a *App) Subscribe(topic string, handler SubscribeFunc) {
+// If the subscriber is not initialized in the container, or no store is available for the deduplication set by
+// WithDeduplication, an error is logged and the subscription is not registered.
+func (a *App) Subscribe(topic string, handler SubscribeFunc, options ...SubscribeOption) {
 	if topic == "" || handler == nil {
 		a.container.Logger.Errorf("invalid subscription: topic and handler must not be empty or nil")
 
@@ -243,7 +290,83 @@ func (a *App) Subscribe(topic string, handler SubscribeFunc) {
 		return
 	}
 
-	a.subscriptionManager.subscriptions[topic] = handler
+	sub := newSubscription(handler, options...)
+
+	if !a.setDeduplicationStore(topic, sub) {
+		return
+	}
+
+	a.subscriptionManager.subscriptions[topic] = sub
+}
+
+// SubscribeBatch registers a handler for the batches of messages of the given topic. A batch is handled once it has
+// maxSize messages, or maxWait after its first message is read. The messages of the batch are committed when the
+// handler succeeds, while the messages reported as failed by a *BatchError are retried and dead-lettered
+// as per the retry policy set by WithRetryPolicy.
+//
+// If the subscriber is not initialized in the container, an error is logged and
+// the subscription is not registered.
+func (a *App) SubscribeBatch(topic string, handler BatchSubscribeFunc, maxSize int, maxWait time.Duration,
+	options ...SubscribeOption) {
+	if topic == "" || handler == nil || maxSize < 1 || maxWait <= 0 {
+		a.container.Logger.Errorf("invalid batch subscription: topic and handler must not be empty or nil, " +
+			"and maxSize and maxWait must be positive")
+
+		return
+	}
+
+	if a.container.GetSubscriber() == nil {
+		a.container.Logger.Errorf("subscriber not initialized in the container")
+
+		return
+	}
+
+	sub := newSubscription(nil, options...)
+	sub.batch = &batchSubscription{handler: handler, maxSize: maxSize, maxWait: maxWait}
+
+	if !a.setDeduplicationStore(topic, sub) {
+		return
+	}
+
+	a.subscriptionManager.subscriptions[topic] = sub
+}
+
+// setDeduplicationStore sets the default store of the deduplication of the subscription, when it has no store.
+// It reports whether the subscription is to be registered, i.e. whether it has a store when it is deduplicated.
+func (a *App) setDeduplicationStore(topic string, sub *subscription) bool {
+	if sub.dedup == nil || sub.dedup.Store != nil {
+		return true
+	}
+
+	if sub.dedup.Store = defaultDeduplicationStore(a.container); sub.dedup.Store == nil {
+		a.container.Logger.Errorf("no Redis, SQL or KVStore initialized in the container for the deduplication of "+
+			"topic %s, the subscription is not registered", topic)
+
+		return false
+	}
+
+	return true
+}
+
+// AddOutboxRelay registers the relay publishing the events added to the outbox table by outbox.Add, through the
+// publisher of the container. The relay runs in the background while the app is running.
+//
+// If the SQL datasource or the publisher is not initialized in the container, an error is logged and
+// the relay is not registered.
+func (a *App) AddOutboxRelay(config outbox.Config) {
+	if isNil(a.container.SQL) || isNil(a.container.GetPublisher()) {
+		a.container.Logger.Errorf("SQL or publisher not initialized in the container, outbox relay is not registered")
+
+		return
+	}
+
+	a.outboxRelay = outbox.NewRelay(a.container, config)
+}
+
+func isNil(i any) bool {
+	val := reflect.ValueOf(i)
+
+	return !val.IsValid() || val.IsNil()
 }
 
 // UseMiddleware is a setter method for adding user defined custom middleware to GoFr's router.
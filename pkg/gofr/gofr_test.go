@@ -22,6 +22,8 @@ import (
 	gofrHTTP "gofr.dev/pkg/gofr/http"
 	"gofr.dev/pkg/gofr/logging"
 	"gofr.dev/pkg/gofr/migration"
+	"gofr.dev/pkg/gofr/outbox"
+	"gofr.dev/pkg/gofr/service"
 	"gofr.dev/pkg/gofr/testutil"
 )
 
@@ -267,6 +269,58 @@ func Test_AddDuplicateHTTPService(t *testing.T) {
 	assert.Contains(t, logs, "Service already registered Name: test-service")
 }
 
+func Test_AddHTTPServiceWithRedisCache(t *testing.T) {
+	_ = testutil.NewServerConfigs(t)
+
+	var calls int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+
+		w.Header().Set("Cache-Control", "max-age=60")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// Redis is not configured, so the responses are expected to be cached in memory.
+	g := New()
+
+	g.AddHTTPService("test-service", server.URL, &service.CacheConfig{UseRedis: true})
+
+	for range 2 {
+		resp, err := g.container.GetHTTPService("test-service").Get(t.Context(), "test", nil)
+		require.NoError(t, err)
+
+		resp.Body.Close()
+	}
+
+	assert.Equal(t, 1, calls)
+}
+
+func Test_AddHTTPServiceWithTransportFromEnv(t *testing.T) {
+	_ = testutil.NewServerConfigs(t)
+
+	t.Setenv("HTTP_SERVICE_TEST_SERVICE_TIMEOUT", "50ms")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	g := New()
+
+	g.AddHTTPService("test-service", server.URL)
+
+	resp, err := g.container.GetHTTPService("test-service").Get(t.Context(), "test", nil)
+	if resp != nil {
+		resp.Body.Close()
+	}
+
+	require.ErrorContains(t, err, "Client.Timeout exceeded")
+}
+
 func TestApp_Metrics(t *testing.T) {
 	testutil.NewServerConfigs(t)
 
@@ -1170,6 +1224,24 @@ func TestApp_Subscribe(t *testing.T) {
 	})
 }
 
+func TestApp_AddOutboxRelay(t *testing.T) {
+	testutil.NewServerConfigs(t)
+
+	app := New()
+	app.container = &container.Container{Logger: logging.NewLogger(logging.ERROR)}
+
+	app.AddOutboxRelay(outbox.Config{})
+
+	assert.Nil(t, app.outboxRelay, "the outbox relay is registered without the SQL datasource and the publisher")
+
+	c, _ := container.NewMockContainer(t)
+	app.container = c
+
+	app.AddOutboxRelay(outbox.Config{})
+
+	assert.NotNil(t, app.outboxRelay)
+}
+
 // Define static error for testing.
 var errHookFailed = errors.New("hook failed")
 
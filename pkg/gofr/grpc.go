@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
 	"google.golang.org/grpc"
@@ -16,6 +18,7 @@ import (
 	"gofr.dev/pkg/gofr/config"
 	"gofr.dev/pkg/gofr/container"
 	gofr_grpc "gofr.dev/pkg/gofr/grpc"
+	"gofr.dev/pkg/gofr/http/middleware"
 )
 
 type grpcServer struct {
@@ -25,6 +28,14 @@ type grpcServer struct {
 	options            []grpc.ServerOption
 	port               int
 	config             config.Config
+	authExemptions     []string
+	// authInterceptors and authStreamInterceptors authenticate the calls with the providers of the app. They are
+	// read on each call, so that auth enabled after the server is created also applies to its calls.
+	authInterceptors       []grpc.UnaryServerInterceptor
+	authStreamInterceptors []grpc.StreamServerInterceptor
+	health                 *grpcHealth
+	// unaryInterceptor chains the unary interceptors of the server, for the calls served outside of it.
+	unaryInterceptor grpc.UnaryServerInterceptor
 }
 
 var (
@@ -86,6 +97,35 @@ func (a *App) AddGRPCServerStreamInterceptors(interceptors ...grpc.StreamServerI
 	a.grpcServer.streamInterceptors = append(a.grpcServer.streamInterceptors, interceptors...)
 }
 
+// AddGRPCAuthExemptions exempts the gRPC methods from the authentication enabled for the app, e.g. with
+// EnableAPIKeyAuth. Each method is given by its full name, e.g. /package.Service/Method, or by the name of its
+// service, e.g. package.Service, to exempt all the methods of the service. The health service is always exempted.
+func (a *App) AddGRPCAuthExemptions(methods ...string) {
+	a.grpcServer.authExemptions = append(a.grpcServer.authExemptions, methods...)
+}
+
+// addAuthProvider authenticates the unary and streaming calls with the provider.
+func (g *grpcServer) addAuthProvider(provider middleware.AuthProvider) {
+	g.authInterceptors = append(g.authInterceptors, gofr_grpc.UnaryAuthInterceptor(provider, g.isAuthExempt))
+	g.authStreamInterceptors = append(g.authStreamInterceptors, gofr_grpc.StreamAuthInterceptor(provider, g.isAuthExempt))
+}
+
+// authInterceptor runs the auth interceptors of the providers added so far on the unary calls.
+func (g *grpcServer) authInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (any, error) {
+	return chainUnaryInterceptors(g.authInterceptors)(ctx, req, info, handler)
+}
+
+// authStreamInterceptor runs the auth interceptors of the providers added so far on the streaming calls.
+func (g *grpcServer) authStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	return chainStreamInterceptors(g.authStreamInterceptors)(srv, ss, info, handler)
+}
+
+func (g *grpcServer) isAuthExempt(fullMethod string) bool {
+	return gofr_grpc.ExemptMethods(g.authExemptions...)(fullMethod)
+}
+
 func newGRPCServer(c *container.Container, port int, cfg config.Config) (*grpcServer, error) {
 	if port <= 0 || port > 65535 {
 		return nil, fmt.Errorf("%w: %d", errInvalidPort, port)
@@ -93,8 +133,8 @@ func newGRPCServer(c *container.Container, port int, cfg config.Config) (*grpcSe
 
 	registerGRPCMetrics(c)
 
-	middleware := make([]grpc.UnaryServerInterceptor, 0)
-	middleware = append(middleware,
+	unaryMiddleware := make([]grpc.UnaryServerInterceptor, 0)
+	unaryMiddleware = append(unaryMiddleware,
 		grpc_recovery.UnaryServerInterceptor(),
 		gofr_grpc.ObservabilityInterceptor(c.Logger, c.Metrics()))
 
@@ -105,9 +145,10 @@ func newGRPCServer(c *container.Container, port int, cfg config.Config) (*grpcSe
 
 	return &grpcServer{
 		port:               port,
-		interceptors:       middleware,
+		interceptors:       unaryMiddleware,
 		streamInterceptors: streamMiddleware,
 		config:             cfg,
+		health:             newGRPCHealth(),
 	}, nil
 }
 
@@ -119,8 +160,14 @@ func registerGRPCMetrics(c *container.Container) {
 }
 
 func (g *grpcServer) createServer() error {
-	interceptorOption := grpc.ChainUnaryInterceptor(g.interceptors...)
-	streamOpt := grpc.ChainStreamInterceptor(g.streamInterceptors...)
+	// the calls are authenticated after the other interceptors, with the providers enabled by the time of each call.
+	interceptors := append(slices.Clone(g.interceptors), g.authInterceptor)
+	streamInterceptors := append(slices.Clone(g.streamInterceptors), g.authStreamInterceptor)
+
+	g.unaryInterceptor = chainUnaryInterceptors(interceptors)
+
+	interceptorOption := grpc.ChainUnaryInterceptor(interceptors...)
+	streamOpt := grpc.ChainStreamInterceptor(streamInterceptors...)
 	g.options = append(g.options, interceptorOption, streamOpt)
 
 	g.server = grpc.NewServer(g.options...)
@@ -136,6 +183,42 @@ func (g *grpcServer) createServer() error {
 	return nil
 }
 
+// chainUnaryInterceptors chains the interceptors into one in the same way as grpc.ChainUnaryInterceptor,
+// the first interceptor being the outermost.
+func chainUnaryInterceptors(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		next := handler
+
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, inner := interceptors[i], next
+
+			next = func(ctx context.Context, req any) (any, error) {
+				return interceptor(ctx, req, info, inner)
+			}
+		}
+
+		return next(ctx, req)
+	}
+}
+
+// chainStreamInterceptors chains the interceptors into one in the same way as grpc.ChainStreamInterceptor,
+// the first interceptor being the outermost.
+func chainStreamInterceptors(interceptors []grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		next := handler
+
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, inner := interceptors[i], next
+
+			next = func(srv any, ss grpc.ServerStream) error {
+				return interceptor(srv, ss, info, inner)
+			}
+		}
+
+		return next(srv, ss)
+	}
+}
+
 func (g *grpcServer) Run(c *container.Container) {
 	if g.server == nil {
 		if err := g.createServer(); err != nil {
@@ -182,7 +265,23 @@ func (g *grpcServer) Run(c *container.Container) {
 	c.Metrics().SetGauge("grpc_server_status", 0)
 }
 
+// healthCheckInterval returns the interval of updating the serving status of the health service.
+func (g *grpcServer) healthCheckInterval(c *container.Container) time.Duration {
+	interval, err := time.ParseDuration(g.config.GetOrDefault("GRPC_HEALTH_CHECK_INTERVAL", "10s"))
+	if err != nil || interval <= 0 {
+		c.Logger.Errorf("invalid GRPC_HEALTH_CHECK_INTERVAL, using the default of %v", defaultGRPCHealthCheckInterval)
+
+		return defaultGRPCHealthCheckInterval
+	}
+
+	return interval
+}
+
 func (g *grpcServer) Shutdown(ctx context.Context) error {
+	if g.health != nil {
+		g.health.stop()
+	}
+
 	return ShutdownWithContext(ctx, func(_ context.Context) error {
 		if g.server != nil {
 			g.server.GracefulStop()
@@ -207,6 +306,7 @@ func (a *App) RegisterService(desc *grpc.ServiceDesc, impl any) {
 
 	a.container.Logger.Infof("registering gRPC Service: %s", desc.ServiceName)
 	a.grpcServer.server.RegisterService(desc, impl)
+	a.grpcServer.health.registerService(desc, impl)
 
 	a.container.Metrics().IncrementCounter(context.Background(), "grpc_services_registered_total")
 
@@ -217,6 +317,53 @@ func (a *App) RegisterService(desc *grpc.ServiceDesc, impl any) {
 
 	a.grpcRegistered = true
 	a.container.Logger.Infof("successfully registered gRPC service: %s", desc.ServiceName)
+
+	// the gRPC-Web routes are registered first, as they match the same paths as the default transcoding routes.
+	if strings.EqualFold(a.Config.Get("GRPC_ENABLE_WEB"), "true") {
+		a.registerGRPCWebService(desc)
+	}
+
+	if strings.EqualFold(a.Config.Get("GRPC_ENABLE_HTTP_TRANSCODING"), "true") {
+		a.registerTranscodedService(desc, impl)
+	}
+}
+
+// AddGRPCClient creates a connection to the gRPC server at target, accessible by the name from the container
+// with GetGRPCClient. The calls made through it are traced, logged and included in metrics, and the health of
+// the server is reported in the health check of the app. Retries can be configured with gofr_grpc.WithRetryPolicy,
+// and a default timeout for the calls with gofr_grpc.WithCallTimeout.
+//
+// Example:
+//
+//	app.AddGRPCClient("hello", "localhost:9000", gofr_grpc.WithRetryPolicy(gofr_grpc.RetryPolicy{MaxAttempts: 3}),
+//		gofr_grpc.WithCallTimeout(2*time.Second))
+//
+//	client := pb.NewHelloClient(ctx.GetGRPCClient("hello"))
+func (a *App) AddGRPCClient(name, target string, dialOptions ...grpc.DialOption) {
+	if a.container.GRPCClients == nil {
+		a.container.GRPCClients = make(map[string]container.GRPCClient)
+
+		a.container.Metrics().NewHistogram("app_gRPC-Client_stats", "Response time of gRPC client in milliseconds.",
+			0.005, 0.01, .05, .075, .1, .125, .15, .2, .3, .5, .75, 1, 2, 3, 4, 5, 7.5, 10)
+	}
+
+	client, err := gofr_grpc.NewClient(name, target, a.container.Logger, a.container.Metrics(), dialOptions...)
+	if err != nil {
+		a.container.Errorf("failed to create gRPC client %s for %s: %v", name, target, err)
+
+		return
+	}
+
+	// the connection of a client registered earlier with the same name is closed, as it is no longer accessible.
+	if existing, ok := a.container.GRPCClients[name]; ok {
+		a.container.Warnf("gRPC client already registered Name: %v, replacing it with the client for %v", name, target)
+
+		if err := existing.Close(); err != nil {
+			a.container.Errorf("failed to close gRPC client %s: %v", name, err)
+		}
+	}
+
+	a.container.GRPCClients[name] = client
 }
 
 func injectContainer(impl any, c *container.Container) error {
@@ -0,0 +1,108 @@
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"gofr.dev/pkg/gofr/http/middleware"
+)
+
+// healthService is served without authentication, so that the orchestrators can probe the server.
+const healthService = "/grpc.health.v1.Health/"
+
+// AuthExemption reports whether the method, e.g. /package.Service/Method, is served without authentication.
+type AuthExemption func(fullMethod string) bool
+
+// ExemptMethods returns an AuthExemption for the methods, each given by its full name, e.g. /package.Service/Method,
+// or by the name of its service, e.g. package.Service, to exempt all the methods of the service.
+func ExemptMethods(methods ...string) AuthExemption {
+	return func(fullMethod string) bool {
+		for _, m := range methods {
+			if fullMethod == m || strings.HasPrefix(fullMethod, "/"+strings.Trim(m, "/")+"/") {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// UnaryAuthInterceptor authenticates the unary RPCs with the provider, using the same credentials as the HTTP
+// server in the metadata of the calls, e.g. authorization: Basic <credentials> or x-api-key: <key>. The identity
+// is added to the context of the call, from which it is read by GetAuthInfo of gofr.Context.
+// The health service and the methods exempted by exempt, which can be nil, are not authenticated.
+func UnaryAuthInterceptor(provider middleware.AuthProvider, exempt AuthExemption) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if isExempt(info.FullMethod, exempt) {
+			return handler(ctx, req)
+		}
+
+		ctx, err := Authenticate(ctx, provider)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthInterceptor authenticates the streaming RPCs with the provider once the stream is opened,
+// in the same way as UnaryAuthInterceptor.
+func StreamAuthInterceptor(provider middleware.AuthProvider, exempt AuthExemption) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if isExempt(info.FullMethod, exempt) {
+			return handler(srv, ss)
+		}
+
+		ctx, err := Authenticate(ss.Context(), provider)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &wrappedServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// Authenticate validates the credentials in the incoming metadata of the call with the provider, returning
+// the context with the identity. The errors have the Unauthenticated or PermissionDenied status codes
+// corresponding to the errors of the HTTP middleware.
+func Authenticate(ctx context.Context, provider middleware.AuthProvider) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	// the providers read the credentials from the headers of an HTTP request.
+	r, _ := http.NewRequestWithContext(ctx, http.MethodPost, "/", http.NoBody)
+
+	for key, values := range md {
+		for _, v := range values {
+			r.Header.Add(key, v)
+		}
+	}
+
+	identity, err := provider.ExtractAuthHeader(r)
+	if err != nil {
+		return ctx, status.Error(authErrorCode(err.StatusCode()), err.Error())
+	}
+
+	return context.WithValue(ctx, provider.GetAuthMethod(), identity), nil
+}
+
+func isExempt(fullMethod string, exempt AuthExemption) bool {
+	return strings.HasPrefix(fullMethod, healthService) || (exempt != nil && exempt(fullMethod))
+}
+
+func authErrorCode(statusCode int) codes.Code {
+	switch statusCode {
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	default:
+		return codes.Internal
+	}
+}
@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"gofr.dev/pkg/gofr/http/middleware"
+)
+
+type forbiddingProvider struct{}
+
+func (forbiddingProvider) GetAuthMethod() middleware.AuthMethod { return middleware.Username }
+
+func (forbiddingProvider) ExtractAuthHeader(*http.Request) (any, middleware.ErrorHTTP) {
+	return nil, middleware.NewUnauthorized("access denied")
+}
+
+func TestUnaryAuthInterceptor(t *testing.T) {
+	provider := &middleware.BasicAuthProvider{Users: map[string]string{"user": "password"}}
+	credentials := "Basic " + base64.StdEncoding.EncodeToString([]byte("user:password"))
+	invalid := "Basic " + base64.StdEncoding.EncodeToString([]byte("user:wrong"))
+
+	testCases := []struct {
+		desc     string
+		provider middleware.AuthProvider
+		method   string
+		md       metadata.MD
+		code     codes.Code
+		username string
+	}{
+		{desc: "valid credentials", provider: provider, method: "/hello.Hello/SayHello",
+			md: metadata.Pairs("authorization", credentials), code: codes.OK, username: "user"},
+		{desc: "missing credentials", provider: provider, method: "/hello.Hello/SayHello", code: codes.Unauthenticated},
+		{desc: "invalid credentials", provider: provider, method: "/hello.Hello/SayHello",
+			md: metadata.Pairs("authorization", invalid), code: codes.Unauthenticated},
+		{desc: "forbidden", provider: forbiddingProvider{}, method: "/hello.Hello/SayHello",
+			md: metadata.Pairs("authorization", credentials), code: codes.PermissionDenied},
+		{desc: "health service", provider: provider, method: "/grpc.health.v1.Health/Check", code: codes.OK},
+		{desc: "exempted method", provider: provider, method: "/hello.Hello/Public", code: codes.OK},
+		{desc: "exempted service", provider: provider, method: "/hello.Open/Any", code: codes.OK},
+	}
+
+	for i, tc := range testCases {
+		interceptor := UnaryAuthInterceptor(tc.provider, ExemptMethods("/hello.Hello/Public", "hello.Open"))
+
+		var username string
+
+		_, err := interceptor(metadata.NewIncomingContext(t.Context(), tc.md), nil,
+			&grpc.UnaryServerInfo{FullMethod: tc.method}, func(ctx context.Context, _ any) (any, error) {
+				username, _ = ctx.Value(middleware.Username).(string)
+
+				return nil, nil
+			})
+
+		assert.Equal(t, tc.code, status.Code(err), "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.username, username, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestStreamAuthInterceptor(t *testing.T) {
+	provider := &middleware.APIKeyAuthProvider{APIKeys: []string{"valid-key"}}
+	interceptor := StreamAuthInterceptor(provider, nil)
+	info := &grpc.StreamServerInfo{FullMethod: "/chat.Chat/Stream"}
+
+	var apiKey string
+
+	handler := func(_ any, stream grpc.ServerStream) error {
+		apiKey, _ = stream.Context().Value(middleware.APIKey).(string)
+
+		return nil
+	}
+
+	ctx := metadata.NewIncomingContext(t.Context(), metadata.Pairs("x-api-key", "valid-key"))
+
+	require.NoError(t, interceptor(nil, &mockServerStream{ctx: ctx}, info, handler))
+	assert.Equal(t, "valid-key", apiKey)
+
+	ctx = metadata.NewIncomingContext(t.Context(), metadata.Pairs("x-api-key", "invalid-key"))
+	err := interceptor(nil, &mockServerStream{ctx: ctx}, info, handler)
+
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,208 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"gofr.dev/pkg/gofr/datasource"
+)
+
+const (
+	clientMetricName       = "app_gRPC-Client_stats"
+	defaultServiceConfig   = `{"loadBalancingPolicy": "round_robin"}`
+	defaultHealthCheckTime = 5 * time.Second
+)
+
+// Client is a connection to a gRPC server created with GoFr observability. As it embeds *grpc.ClientConn,
+// it can be passed to the constructors of the generated gRPC clients, e.g. pb.NewHelloClient(client).
+type Client struct {
+	*grpc.ClientConn
+
+	name   string
+	target string
+	health grpc_health_v1.HealthClient
+}
+
+// NewClient creates a connection to the gRPC server at target. The calls made through it are traced, logged
+// in the format of the gRPC server logs and recorded in the app_gRPC-Client_stats metric. The connection is
+// insecure and load balanced in round-robin by default, which can be overridden by the dial options.
+func NewClient(name, target string, logger Logger, metrics Metrics, dialOptions ...grpc.DialOption) (*Client, error) {
+	options := make([]grpc.DialOption, 0, len(dialOptions)+4)
+	options = append(options,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(defaultServiceConfig),
+		grpc.WithChainUnaryInterceptor(ObservabilityClientInterceptor(logger, metrics)),
+		grpc.WithChainStreamInterceptor(StreamObservabilityClientInterceptor(logger, metrics)),
+	)
+	options = append(options, dialOptions...)
+
+	conn, err := grpc.NewClient(target, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{ClientConn: conn, name: name, target: target, health: grpc_health_v1.NewHealthClient(conn)}, nil
+}
+
+// HealthCheck reports the health of the server using the standard gRPC health checking protocol. Servers
+// not implementing the protocol are reported UP if they can be reached.
+func (c *Client) HealthCheck(ctx context.Context) *datasource.Health {
+	h := &datasource.Health{
+		Status:  datasource.StatusDown,
+		Details: map[string]any{"name": c.name, "target": c.target},
+	}
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, defaultHealthCheckTime)
+		defer cancel()
+	}
+
+	resp, err := c.health.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+
+	switch {
+	case status.Code(err) == codes.Unimplemented:
+		h.Status = datasource.StatusUp
+	case err != nil:
+		h.Details["error"] = err.Error()
+	case resp.GetStatus() == grpc_health_v1.HealthCheckResponse_SERVING:
+		h.Status = datasource.StatusUp
+	default:
+		h.Details["serving_status"] = resp.GetStatus().String()
+	}
+
+	return h
+}
+
+// RetryPolicy configures the retries of the failed calls made through a client.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts of a call, including the original one. gRPC caps it at 5.
+	MaxAttempts int
+	// InitialBackoff and MaxBackoff bound the randomized delay between the attempts,
+	// which grows by BackoffMultiplier after each attempt.
+	InitialBackoff    time.Duration
+	MaxBackoff        time.Duration
+	BackoffMultiplier float64
+	// RetryableStatusCodes are the status codes on which a call is retried. Defaults to UNAVAILABLE.
+	RetryableStatusCodes []codes.Code
+}
+
+// WithRetryPolicy returns a dial option which retries the failed calls of all the methods as per the policy.
+// It replaces the default service config of the client, keeping the round-robin load balancing.
+func WithRetryPolicy(policy RetryPolicy) grpc.DialOption {
+	return grpc.WithDefaultServiceConfig(policy.serviceConfig())
+}
+
+func (p RetryPolicy) serviceConfig() string {
+	maxAttempts := max(p.MaxAttempts, 2)
+	initialBackoff := p.InitialBackoff
+
+	if initialBackoff <= 0 {
+		initialBackoff = 100 * time.Millisecond
+	}
+
+	maxBackoff := max(p.MaxBackoff, initialBackoff)
+
+	multiplier := p.BackoffMultiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+
+	statusCodes := p.RetryableStatusCodes
+	if len(statusCodes) == 0 {
+		statusCodes = []codes.Code{codes.Unavailable}
+	}
+
+	config := map[string]any{
+		"loadBalancingPolicy": "round_robin",
+		"methodConfig": []map[string]any{{
+			"name": []map[string]any{{}},
+			"retryPolicy": map[string]any{
+				"maxAttempts":          maxAttempts,
+				"initialBackoff":       durationString(initialBackoff),
+				"maxBackoff":           durationString(maxBackoff),
+				"backoffMultiplier":    multiplier,
+				"retryableStatusCodes": statusCodes,
+			},
+		}},
+	}
+
+	data, _ := json.Marshal(config)
+
+	return string(data)
+}
+
+// durationString formats the duration as expected in service configs, e.g. 0.5s.
+func durationString(d time.Duration) string {
+	return fmt.Sprintf("%.9gs", d.Seconds())
+}
+
+// ObservabilityClientInterceptor handles logging, metrics, and tracing for the unary RPCs made by a client.
+func ObservabilityClientInterceptor(logger Logger, metrics Metrics) grpc.UnaryClientInterceptor {
+	tracer := otel.GetTracerProvider().Tracer("gofr-gRPC-client", trace.WithInstrumentationVersion("v0.1"))
+
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+
+		ctx, span := tracer.Start(ctx, "gRPC-srv-call: "+method)
+		defer span.End()
+
+		err := invoker(propagateSpanContext(ctx), method, req, reply, cc, opts...)
+
+		logRPC(ctx, logger, metrics, start, err, method, clientMetricName)
+
+		return err
+	}
+}
+
+// StreamObservabilityClientInterceptor handles logging, metrics, and tracing for the streaming RPCs made by a client.
+// The stream is logged once it is created.
+func StreamObservabilityClientInterceptor(logger Logger, metrics Metrics) grpc.StreamClientInterceptor {
+	tracer := otel.GetTracerProvider().Tracer("gofr-gRPC-client-stream", trace.WithInstrumentationVersion("v0.1"))
+
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+		streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		start := time.Now()
+
+		ctx, span := tracer.Start(ctx, "gRPC-srv-stream: "+method)
+		defer span.End()
+
+		stream, err := streamer(propagateSpanContext(ctx), desc, cc, method, opts...)
+
+		streamType, methodName := getStreamTypeAndMethod(&grpc.StreamServerInfo{
+			FullMethod:     method,
+			IsClientStream: desc.ClientStreams,
+			IsServerStream: desc.ServerStreams,
+		})
+
+		logStreamRPC(ctx, logger, metrics, start, err, methodName, streamType, clientMetricName)
+
+		return stream, err
+	}
+}
+
+// propagateSpanContext adds the trace and span IDs of the call to the outgoing metadata, from which
+// GoFr gRPC servers continue the trace.
+func propagateSpanContext(ctx context.Context) context.Context {
+	spanContext := trace.SpanFromContext(ctx).SpanContext()
+	if !spanContext.IsValid() {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx,
+		"x-gofr-traceid", spanContext.TraceID().String(),
+		"x-gofr-spanid", spanContext.SpanID().String())
+}
@@ -0,0 +1,177 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"gofr.dev/pkg/gofr/datasource"
+)
+
+// startHealthServer starts a gRPC server with the health service, if given, and returns its address.
+func startHealthServer(t *testing.T, healthServer *health.Server, interceptor grpc.UnaryServerInterceptor) string {
+	t.Helper()
+
+	listener, err := (&net.ListenConfig{}).Listen(t.Context(), "tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	var options []grpc.ServerOption
+	if interceptor != nil {
+		options = append(options, grpc.UnaryInterceptor(interceptor))
+	}
+
+	server := grpc.NewServer(options...)
+	if healthServer != nil {
+		grpc_health_v1.RegisterHealthServer(server, healthServer)
+	}
+
+	go func() { _ = server.Serve(listener) }()
+
+	t.Cleanup(server.Stop)
+
+	return listener.Addr().String()
+}
+
+func TestClient_HealthCheck(t *testing.T) {
+	healthServer := health.NewServer()
+	address := startHealthServer(t, healthServer, nil)
+
+	client, err := NewClient("hello", address, nil, nil)
+	require.NoError(t, err)
+
+	defer client.Close()
+
+	h := client.HealthCheck(t.Context())
+	assert.Equal(t, datasource.StatusUp, h.Status)
+	assert.Equal(t, map[string]any{"name": "hello", "target": address}, h.Details)
+
+	healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+
+	h = client.HealthCheck(t.Context())
+	assert.Equal(t, datasource.StatusDown, h.Status)
+	assert.Equal(t, "NOT_SERVING", h.Details["serving_status"])
+}
+
+func TestClient_HealthCheck_WithoutHealthService(t *testing.T) {
+	address := startHealthServer(t, nil, nil)
+
+	client, err := NewClient("hello", address, nil, nil)
+	require.NoError(t, err)
+
+	defer client.Close()
+
+	assert.Equal(t, datasource.StatusUp, client.HealthCheck(t.Context()).Status)
+}
+
+func TestClient_HealthCheck_Unreachable(t *testing.T) {
+	client, err := NewClient("hello", "127.0.0.1:1", nil, nil)
+	require.NoError(t, err)
+
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+	defer cancel()
+
+	h := client.HealthCheck(ctx)
+	assert.Equal(t, datasource.StatusDown, h.Status)
+	assert.Contains(t, h.Details, "error")
+}
+
+func TestClient_Observability(t *testing.T) {
+	mockLogger, mockMetrics, _ := createMocks(t)
+
+	traceIDs := make(chan string, 1)
+
+	address := startHealthServer(t, health.NewServer(), func(ctx context.Context, req any, _ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		traceIDs <- getMetadataValue(md, "x-gofr-traceid")
+
+		return handler(ctx, req)
+	})
+
+	client, err := NewClient("hello", address, mockLogger, mockMetrics)
+	require.NoError(t, err)
+
+	defer client.Close()
+
+	mockLogger.EXPECT().Info(gomock.Any())
+	mockMetrics.EXPECT().RecordHistogram(gomock.Any(), "app_gRPC-Client_stats", gomock.Any(),
+		"method", "/grpc.health.v1.Health/Check")
+
+	traceID, _ := trace.TraceIDFromHex("12345678901234567890123456789012")
+	spanID, _ := trace.SpanIDFromHex("1234567890123456")
+	ctx := trace.ContextWithRemoteSpanContext(t.Context(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	_, err = grpc_health_v1.NewHealthClient(client).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	require.NoError(t, err)
+
+	assert.Equal(t, traceID.String(), <-traceIDs)
+}
+
+func TestRetryPolicy_ServiceConfig(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts:          4,
+		InitialBackoff:       200 * time.Millisecond,
+		MaxBackoff:           2 * time.Second,
+		BackoffMultiplier:    1.5,
+		RetryableStatusCodes: []codes.Code{codes.Unavailable, codes.ResourceExhausted},
+	}
+
+	var config map[string]any
+
+	require.NoError(t, json.Unmarshal([]byte(policy.serviceConfig()), &config))
+
+	assert.Equal(t, "round_robin", config["loadBalancingPolicy"])
+
+	retryPolicy := config["methodConfig"].([]any)[0].(map[string]any)["retryPolicy"]
+
+	assert.Equal(t, map[string]any{
+		"maxAttempts":          float64(4),
+		"initialBackoff":       "0.2s",
+		"maxBackoff":           "2s",
+		"backoffMultiplier":    1.5,
+		"retryableStatusCodes": []any{float64(codes.Unavailable), float64(codes.ResourceExhausted)},
+	}, retryPolicy)
+}
+
+func TestWithRetryPolicy_RetriesUnavailableCalls(t *testing.T) {
+	var attempts int
+
+	address := startHealthServer(t, health.NewServer(), func(ctx context.Context, req any, _ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (any, error) {
+		attempts++
+		if attempts < 3 {
+			return nil, status.Error(codes.Unavailable, "unavailable")
+		}
+
+		return handler(ctx, req)
+	})
+
+	client, err := NewClient("hello", address, nil, nil,
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}))
+	require.NoError(t, err)
+
+	defer client.Close()
+
+	_, err = grpc_health_v1.NewHealthClient(client).Check(t.Context(), &grpc_health_v1.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+}
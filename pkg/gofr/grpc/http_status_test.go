@@ -0,0 +1,36 @@
+package grpc
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	gofrHTTP "gofr.dev/pkg/gofr/http"
+)
+
+func TestHTTPStatusFromCode(t *testing.T) {
+	testCases := []struct {
+		code   codes.Code
+		status int
+	}{
+		{codes.OK, http.StatusOK},
+		{codes.Canceled, gofrHTTP.StatusClientClosedRequest},
+		{codes.InvalidArgument, http.StatusBadRequest},
+		{codes.DeadlineExceeded, http.StatusGatewayTimeout},
+		{codes.NotFound, http.StatusNotFound},
+		{codes.AlreadyExists, http.StatusConflict},
+		{codes.PermissionDenied, http.StatusForbidden},
+		{codes.Unauthenticated, http.StatusUnauthorized},
+		{codes.ResourceExhausted, http.StatusTooManyRequests},
+		{codes.Unimplemented, http.StatusNotImplemented},
+		{codes.Unavailable, http.StatusServiceUnavailable},
+		{codes.Internal, http.StatusInternalServerError},
+		{codes.Unknown, http.StatusInternalServerError},
+	}
+
+	for i, tc := range testCases {
+		assert.Equal(t, tc.status, HTTPStatusFromCode(tc.code), "TEST[%d], Failed.\n%s", i, tc.code)
+	}
+}
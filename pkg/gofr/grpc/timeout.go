@@ -0,0 +1,39 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// WithCallTimeout returns a dial option which fails the unary calls taking longer than the given timeout, unless
+// the context of the call has an earlier deadline. The time left for a call is propagated to the server by gRPC in
+// the grpc-timeout metadata, so GoFr gRPC servers and the HTTP services they call stop working on it once it elapses.
+func WithCallTimeout(timeout time.Duration) grpc.DialOption {
+	return grpc.WithChainUnaryInterceptor(timeoutUnaryClientInterceptor(timeout))
+}
+
+// timeoutUnaryClientInterceptor applies the timeout to the unary calls made through the client.
+func timeoutUnaryClientInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, cancel := withCallTimeout(ctx, timeout)
+		defer cancel()
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// withCallTimeout shortens the deadline of the context to the timeout, if it is later or not set.
+func withCallTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= timeout {
+		return ctx, func() {}
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestTimeoutUnaryClientInterceptor(t *testing.T) {
+	shortCtx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+	defer cancel()
+
+	longCtx, cancelLong := context.WithTimeout(t.Context(), time.Minute)
+	defer cancelLong()
+
+	testCases := []struct {
+		desc     string
+		ctx      context.Context
+		timeout  time.Duration
+		expected time.Duration
+	}{
+		{"timeout applied without deadline", t.Context(), time.Second, time.Second},
+		{"earlier deadline kept", shortCtx, time.Second, 100 * time.Millisecond},
+		{"later deadline shortened", longCtx, 50 * time.Millisecond, 50 * time.Millisecond},
+	}
+
+	for i, tc := range testCases {
+		interceptor := timeoutUnaryClientInterceptor(tc.timeout)
+
+		err := interceptor(tc.ctx, "/test.Service/Method", nil, nil, nil,
+			func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+				deadline, ok := ctx.Deadline()
+				require.True(t, ok, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+				assert.InDelta(t, tc.expected, time.Until(deadline), float64(20*time.Millisecond),
+					"TEST[%d], Failed.\n%s", i, tc.desc)
+
+				return nil
+			})
+
+		require.NoError(t, err)
+	}
+}
+
+func TestTimeoutUnaryClientInterceptor_NoTimeout(t *testing.T) {
+	interceptor := timeoutUnaryClientInterceptor(0)
+
+	err := interceptor(t.Context(), "/test.Service/Method", nil, nil, nil,
+		func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			_, ok := ctx.Deadline()
+			assert.False(t, ok)
+
+			return nil
+		})
+
+	require.NoError(t, err)
+}
+
+func TestWithCallTimeout(t *testing.T) {
+	address := startHealthServer(t, health.NewServer(), func(ctx context.Context, _ any, _ *grpc.UnaryServerInfo,
+		_ grpc.UnaryHandler) (any, error) {
+		<-ctx.Done()
+
+		return nil, ctx.Err()
+	})
+
+	client, err := NewClient("hello", address, nil, nil, WithCallTimeout(50*time.Millisecond))
+	require.NoError(t, err)
+
+	defer client.Close()
+
+	_, err = grpc_health_v1.NewHealthClient(client).Check(t.Context(), &grpc_health_v1.HealthCheckRequest{})
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+}
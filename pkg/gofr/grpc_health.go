@@ -0,0 +1,114 @@
+package gofr
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"gofr.dev/pkg/gofr/container"
+)
+
+const defaultGRPCHealthCheckInterval = 10 * time.Second
+
+// grpcHealth serves the standard gRPC health service, with the serving status of the server and of each
+// registered service derived from the health of the dependencies of the app.
+type grpcHealth struct {
+	mu       sync.Mutex
+	server   *health.Server
+	services []string
+
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+func newGRPCHealth() *grpcHealth {
+	return &grpcHealth{done: make(chan struct{})}
+}
+
+// registerService records a service registered on the gRPC server. The health server registered by the app,
+// e.g. by the code generated by gofr-cli, is used instead of registering a new one.
+func (h *grpcHealth) registerService(desc *grpc.ServiceDesc, impl any) {
+	if desc.ServiceName != grpc_health_v1.Health_ServiceDesc.ServiceName {
+		h.services = append(h.services, desc.ServiceName)
+
+		return
+	}
+
+	if server, ok := impl.(*health.Server); ok {
+		h.mu.Lock()
+		h.server = server
+		h.mu.Unlock()
+	}
+}
+
+// start registers the health service, if the app has not, and updates the serving status every interval
+// until stop is called.
+func (h *grpcHealth) start(s *grpc.Server, c *container.Container, interval time.Duration) {
+	h.mu.Lock()
+
+	if _, ok := s.GetServiceInfo()[grpc_health_v1.Health_ServiceDesc.ServiceName]; !ok {
+		h.server = health.NewServer()
+		grpc_health_v1.RegisterHealthServer(s, h.server)
+	}
+
+	server := h.server
+
+	h.mu.Unlock()
+
+	if server == nil {
+		c.Logger.Debug("gRPC health service is registered by the app, its serving status is not updated")
+
+		return
+	}
+
+	h.update(server, c)
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-h.done:
+				return
+			case <-ticker.C:
+				h.update(server, c)
+			}
+		}
+	}()
+}
+
+// update sets the serving status of the server and all the services as per the health of the dependencies.
+func (h *grpcHealth) update(server *health.Server, c *container.Container) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultGRPCHealthCheckInterval)
+	defer cancel()
+
+	servingStatus := grpc_health_v1.HealthCheckResponse_SERVING
+
+	if healthMap, _ := c.Health(ctx).(map[string]any); healthMap["status"] != "UP" {
+		servingStatus = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+
+	server.SetServingStatus("", servingStatus)
+
+	for _, service := range h.services {
+		server.SetServingStatus(service, servingStatus)
+	}
+}
+
+// stop sets the serving status of the server and all the services to NOT_SERVING, ignoring any later updates,
+// so that the clients stop sending calls to the server while it is shutting down.
+func (h *grpcHealth) stop() {
+	h.stopOnce.Do(func() { close(h.done) })
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.server != nil {
+		h.server.Shutdown()
+	}
+}
@@ -0,0 +1,78 @@
+package gofr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"gofr.dev/pkg/gofr/config"
+	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/service"
+)
+
+func servingStatus(t *testing.T, server *health.Server, serviceName string) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	t.Helper()
+
+	resp, err := server.Check(t.Context(), &grpc_health_v1.HealthCheckRequest{Service: serviceName})
+	require.NoError(t, err)
+
+	return resp.GetStatus()
+}
+
+func TestGRPCHealth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	svc := service.NewMockHTTP(ctrl)
+
+	c := container.NewContainer(config.NewMockConfig(nil))
+	c.Services = map[string]service.HTTP{"payments": svc}
+
+	svc.EXPECT().HealthCheck(gomock.Any()).Return(&service.Health{Status: "UP"})
+
+	h := newGRPCHealth()
+	h.registerService(&grpc.ServiceDesc{ServiceName: "hello.Hello"}, nil)
+
+	s := grpc.NewServer()
+	h.start(s, c, time.Hour)
+
+	require.Contains(t, s.GetServiceInfo(), grpc_health_v1.Health_ServiceDesc.ServiceName)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, servingStatus(t, h.server, ""))
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, servingStatus(t, h.server, "hello.Hello"))
+
+	svc.EXPECT().HealthCheck(gomock.Any()).Return(&service.Health{Status: "DOWN"})
+	h.update(h.server, c)
+
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus(t, h.server, ""))
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus(t, h.server, "hello.Hello"))
+
+	svc.EXPECT().HealthCheck(gomock.Any()).Return(&service.Health{Status: "UP"})
+	h.update(h.server, c)
+	h.stop()
+
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus(t, h.server, ""))
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus(t, h.server, "hello.Hello"))
+}
+
+func TestGRPCHealth_RegisteredByApp(t *testing.T) {
+	c := container.NewContainer(config.NewMockConfig(nil))
+	s := grpc.NewServer()
+
+	server := health.NewServer()
+	grpc_health_v1.RegisterHealthServer(s, server)
+
+	h := newGRPCHealth()
+	h.registerService(&grpc_health_v1.Health_ServiceDesc, server)
+	h.start(s, c, time.Hour)
+
+	assert.Same(t, server, h.server)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, servingStatus(t, server, ""))
+
+	h.stop()
+
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus(t, server, ""))
+}
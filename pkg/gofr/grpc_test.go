@@ -12,11 +12,18 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/dynamicpb"
 
 	"gofr.dev/pkg/gofr/config"
 	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/datasource"
+	"gofr.dev/pkg/gofr/http/middleware"
 	"gofr.dev/pkg/gofr/logging"
 	"gofr.dev/pkg/gofr/testutil"
 )
@@ -420,3 +427,122 @@ func TestApp_WithReflection(t *testing.T) {
 	_, ok := services["grpc.reflection.v1alpha.ServerReflection"]
 	assert.True(t, ok, "reflection service should be registered")
 }
+
+func TestApp_AddGRPCClient(t *testing.T) {
+	_ = testutil.NewServerConfigs(t)
+
+	listener, err := (&net.ListenConfig{}).Listen(t.Context(), "tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+
+	go func() { _ = server.Serve(listener) }()
+
+	defer server.Stop()
+
+	app := New()
+	app.AddGRPCClient("hello", listener.Addr().String())
+
+	client := app.container.GetGRPCClient("hello")
+	require.NotNil(t, client)
+
+	defer client.Close()
+
+	resp, err := grpc_health_v1.NewHealthClient(client).Check(t.Context(), &grpc_health_v1.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, resp.GetStatus())
+
+	healthMap, ok := app.container.Health(t.Context()).(map[string]any)
+	require.True(t, ok)
+
+	clientHealth, ok := healthMap["hello"].(*datasource.Health)
+	require.True(t, ok)
+	assert.Equal(t, datasource.StatusUp, clientHealth.Status)
+}
+
+func TestApp_AddGRPCClient_Duplicate(t *testing.T) {
+	_ = testutil.NewServerConfigs(t)
+
+	var first, second container.GRPCClient
+
+	logs := testutil.StdoutOutputForFunc(func() {
+		app := New()
+
+		app.AddGRPCClient("hello", "localhost:9000")
+		first = app.container.GetGRPCClient("hello")
+
+		app.AddGRPCClient("hello", "localhost:9001")
+		second = app.container.GetGRPCClient("hello")
+	})
+
+	require.NotNil(t, second)
+
+	defer second.Close()
+
+	assert.NotEqual(t, first, second)
+	assert.Contains(t, logs, "gRPC client already registered Name: hello")
+
+	// the connection of the replaced client is closed.
+	_, err := grpc_health_v1.NewHealthClient(first).Check(t.Context(), &grpc_health_v1.HealthCheckRequest{})
+	assert.Equal(t, codes.Canceled, status.Code(err))
+}
+
+func TestApp_EnableAuth_GRPC(t *testing.T) {
+	app := New()
+
+	app.EnableAPIKeyAuth("valid-key")
+	app.AddGRPCAuthExemptions("hello.Open")
+
+	require.Len(t, app.grpcServer.authInterceptors, 1)
+	require.Len(t, app.grpcServer.authStreamInterceptors, 1)
+
+	interceptor := app.grpcServer.authInterceptor
+	handler := func(ctx context.Context, _ any) (any, error) {
+		return ctx.Value(middleware.APIKey), nil
+	}
+
+	ctx := metadata.NewIncomingContext(t.Context(), metadata.Pairs("x-api-key", "valid-key"))
+	resp, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/hello.Hello/SayHello"}, handler)
+
+	require.NoError(t, err)
+	assert.Equal(t, "valid-key", resp)
+
+	_, err = interceptor(t.Context(), nil, &grpc.UnaryServerInfo{FullMethod: "/hello.Hello/SayHello"}, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = interceptor(t.Context(), nil, &grpc.UnaryServerInfo{FullMethod: "/hello.Open/Any"}, handler)
+	assert.NoError(t, err)
+}
+
+func TestApp_EnableAuth_GRPC_AfterRegisterService(t *testing.T) {
+	_ = testutil.NewServerConfigs(t)
+
+	app := New()
+
+	app.RegisterService(transcodingTestServiceDesc(), &struct{}{})
+	app.EnableAPIKeyAuth("valid-key")
+
+	listener, err := (&net.ListenConfig{}).Listen(t.Context(), "tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	go func() { _ = app.grpcServer.server.Serve(listener) }()
+
+	defer app.grpcServer.server.Stop()
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	input := transcodingTestFile.Messages().ByName("GreetRequest")
+	method := "/" + transcodingTestService + "/Echo"
+
+	err = conn.Invoke(t.Context(), method, dynamicpb.NewMessage(input), dynamicpb.NewMessage(input))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := metadata.AppendToOutgoingContext(t.Context(), "x-api-key", "valid-key")
+
+	err = conn.Invoke(ctx, method, dynamicpb.NewMessage(input), dynamicpb.NewMessage(input))
+	require.NoError(t, err)
+}
@@ -0,0 +1,494 @@
+package gofr
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	gofr_grpc "gofr.dev/pkg/gofr/grpc"
+)
+
+var (
+	errInvalidPathTemplate = errors.New("invalid path template")
+	errUnknownField        = errors.New("unknown field")
+	errUnsupportedField    = errors.New("unsupported field")
+	errNotProtoMessage     = errors.New("request is not a protobuf message")
+)
+
+// transcodingRoute is an HTTP route to a unary method of a gRPC service.
+type transcodingRoute struct {
+	httpMethod string
+	// pattern is the path of the route in the format of the router.
+	pattern string
+	// pathFields are the request fields set from the variables of the path, by variable name.
+	pathFields map[string]string
+	// body is the request field set from the body, "*" for the whole request or empty if the request has no body.
+	body string
+	// responseBody is the response field sent as the body, or empty for the whole response.
+	responseBody string
+}
+
+// registerTranscodedService exposes the unary methods of the gRPC service over HTTP, as per their google.api.http
+// annotations, or else at POST /<package>.<Service>/<Method> with the request as the JSON body.
+func (a *App) registerTranscodedService(desc *grpc.ServiceDesc, impl any) {
+	serviceDesc, _ := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(desc.ServiceName))
+	service, _ := serviceDesc.(protoreflect.ServiceDescriptor)
+
+	for i := range desc.Methods {
+		method := desc.Methods[i]
+
+		var methodDesc protoreflect.MethodDescriptor
+		if service != nil {
+			methodDesc = service.Methods().ByName(protoreflect.Name(method.MethodName))
+		}
+
+		routes, err := transcodingRoutes(desc.ServiceName, method.MethodName, methodDesc)
+		if err != nil {
+			a.container.Errorf("failed to expose gRPC method %s/%s over HTTP: %v", desc.ServiceName, method.MethodName, err)
+
+			continue
+		}
+
+		for _, route := range routes {
+			a.addHTTPRoute(route.httpMethod, route.pattern, &transcodingHandler{
+				route:          route,
+				method:         &method,
+				impl:           impl,
+				requestTimeout: a.requestTimeout(),
+				interceptor:    a.grpcServer.unaryInterceptor,
+			})
+
+			a.container.Debugf("exposed gRPC method %s/%s at %s %s", desc.ServiceName, method.MethodName,
+				route.httpMethod, route.pattern)
+		}
+	}
+}
+
+func transcodingRoutes(serviceName, methodName string, methodDesc protoreflect.MethodDescriptor) ([]transcodingRoute, error) {
+	var rule *annotations.HttpRule
+
+	if methodDesc != nil {
+		rule, _ = proto.GetExtension(methodDesc.Options(), annotations.E_Http).(*annotations.HttpRule)
+	}
+
+	if rule == nil || rule.GetPattern() == nil {
+		return []transcodingRoute{{
+			httpMethod: http.MethodPost,
+			pattern:    "/" + serviceName + "/" + methodName,
+			body:       "*",
+		}}, nil
+	}
+
+	rules := append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...)
+	routes := make([]transcodingRoute, 0, len(rules))
+
+	for _, r := range rules {
+		route, err := transcodingRouteFromRule(r)
+		if err != nil {
+			return nil, err
+		}
+
+		routes = append(routes, route)
+	}
+
+	return routes, nil
+}
+
+func transcodingRouteFromRule(rule *annotations.HttpRule) (transcodingRoute, error) {
+	var httpMethod, path string
+
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		httpMethod, path = http.MethodGet, pattern.Get
+	case *annotations.HttpRule_Post:
+		httpMethod, path = http.MethodPost, pattern.Post
+	case *annotations.HttpRule_Put:
+		httpMethod, path = http.MethodPut, pattern.Put
+	case *annotations.HttpRule_Patch:
+		httpMethod, path = http.MethodPatch, pattern.Patch
+	case *annotations.HttpRule_Delete:
+		httpMethod, path = http.MethodDelete, pattern.Delete
+	case *annotations.HttpRule_Custom:
+		httpMethod, path = pattern.Custom.GetKind(), pattern.Custom.GetPath()
+	}
+
+	routerPattern, pathFields, err := routerPattern(path)
+	if err != nil {
+		return transcodingRoute{}, err
+	}
+
+	return transcodingRoute{
+		httpMethod:   httpMethod,
+		pattern:      routerPattern,
+		pathFields:   pathFields,
+		body:         rule.GetBody(),
+		responseBody: rule.GetResponseBody(),
+	}, nil
+}
+
+// routerPattern converts a path template of google.api.http, e.g. /v1/{name=shelves/*}/books, to a pattern
+// of the router, returning the request field of each path variable.
+func routerPattern(template string) (pattern string, fields map[string]string, err error) {
+	var sb strings.Builder
+
+	fields = make(map[string]string)
+
+	for template != "" {
+		start := strings.IndexByte(template, '{')
+		if start < 0 {
+			sb.WriteString(template)
+
+			break
+		}
+
+		end := strings.IndexByte(template[start:], '}')
+		if end < 0 {
+			return "", nil, fmt.Errorf("%w: %q", errInvalidPathTemplate, template)
+		}
+
+		end += start
+
+		field, segments, _ := strings.Cut(template[start+1:end], "=")
+		variable := "v" + strconv.Itoa(len(fields))
+		fields[variable] = field
+
+		sb.WriteString(template[:start])
+		sb.WriteString("{" + variable + ":" + segmentsRegex(segments) + "}")
+
+		template = template[end+1:]
+	}
+
+	return sb.String(), fields, nil
+}
+
+// segmentsRegex returns the regular expression matching the segments of a path variable, where * matches
+// a single segment and ** matches any number of segments.
+func segmentsRegex(segments string) string {
+	if segments == "" {
+		return "[^/]+"
+	}
+
+	parts := strings.Split(segments, "/")
+	for i, part := range parts {
+		switch part {
+		case "*":
+			parts[i] = "[^/]+"
+		case "**":
+			parts[i] = ".+"
+		default:
+			parts[i] = regexp.QuoteMeta(part)
+		}
+	}
+
+	return strings.Join(parts, "/")
+}
+
+// transcodingHandler serves a unary method of a gRPC service over HTTP, converting the JSON body, path variables
+// and query parameters of the request to the request message, and the response message to JSON.
+type transcodingHandler struct {
+	route          transcodingRoute
+	method         *grpc.MethodDesc
+	impl           any
+	requestTimeout time.Duration
+	// interceptor runs the unary interceptors of the gRPC server around the method.
+	interceptor grpc.UnaryServerInterceptor
+}
+
+func (t *transcodingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if timeout := (handler{requestTimeout: t.requestTimeout}).timeout(r); timeout != 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	ctx = metadata.NewIncomingContext(ctx, incomingMetadata(r.Header))
+
+	resp, err := t.method.Handler(t.impl, ctx, func(req any) error {
+		return t.decodeRequest(r, req)
+	}, t.interceptor)
+	if err != nil {
+		writeTranscodingError(w, err)
+
+		return
+	}
+
+	body, err := t.encodeResponse(resp)
+	if err != nil {
+		writeTranscodingError(w, status.Error(codes.Internal, err.Error()))
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	_, _ = w.Write(body)
+}
+
+func (t *transcodingHandler) decodeRequest(r *http.Request, req any) error {
+	message, ok := req.(proto.Message)
+	if !ok {
+		return status.Error(codes.Internal, errNotProtoMessage.Error())
+	}
+
+	msg := message.ProtoReflect()
+
+	// the body is decoded first, as decoding it resets the message.
+	if err := t.decodeBody(r, msg); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid request body: %v", err)
+	}
+
+	// query parameters set the fields which are not set from the body.
+	if t.route.body != "*" {
+		for key, values := range r.URL.Query() {
+			if err := setField(msg, key, values); err != nil && !errors.Is(err, errUnknownField) {
+				return status.Errorf(codes.InvalidArgument, "invalid query parameter %q: %v", key, err)
+			}
+		}
+	}
+
+	vars := mux.Vars(r)
+
+	for variable, field := range t.route.pathFields {
+		if err := setField(msg, field, []string{vars[variable]}); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid path parameter %q: %v", field, err)
+		}
+	}
+
+	return nil
+}
+
+func (t *transcodingHandler) decodeBody(r *http.Request, msg protoreflect.Message) error {
+	if t.route.body == "" {
+		return nil
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil || len(body) == 0 {
+		return err
+	}
+
+	options := protojson.UnmarshalOptions{DiscardUnknown: true}
+
+	if t.route.body == "*" {
+		return options.Unmarshal(body, msg.Interface())
+	}
+
+	// the body is set on a single field, by decoding it as an object with only that field.
+	fd, err := lookupField(msg, t.route.body)
+	if err != nil {
+		return err
+	}
+
+	wrapped, err := json.Marshal(map[string]json.RawMessage{fd.JSONName(): body})
+	if err != nil {
+		return err
+	}
+
+	return options.Unmarshal(wrapped, msg.Interface())
+}
+
+func (t *transcodingHandler) encodeResponse(resp any) ([]byte, error) {
+	message, ok := resp.(proto.Message)
+	if !ok {
+		return json.Marshal(resp)
+	}
+
+	if t.route.responseBody == "" {
+		return protojson.Marshal(message)
+	}
+
+	msg := message.ProtoReflect()
+
+	fd, err := lookupField(msg, t.route.responseBody)
+	if err != nil {
+		return nil, err
+	}
+
+	// the response field is encoded by encoding the response with only that field and extracting it.
+	data, err := protojson.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	if value, ok := fields[fd.JSONName()]; ok {
+		return value, nil
+	}
+
+	return []byte("null"), nil
+}
+
+// incomingMetadata converts the headers of the request to the metadata of the gRPC call.
+func incomingMetadata(header http.Header) metadata.MD {
+	md := make(metadata.MD, len(header))
+
+	for key, values := range header {
+		md[strings.ToLower(key)] = values
+	}
+
+	return md
+}
+
+func writeTranscodingError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(gofr_grpc.HTTPStatusFromCode(st.Code()))
+
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"error": map[string]any{
+			"message": st.Message(),
+			"code":    st.Code().String(),
+		},
+	})
+}
+
+func lookupField(msg protoreflect.Message, name string) (protoreflect.FieldDescriptor, error) {
+	fields := msg.Descriptor().Fields()
+
+	fd := fields.ByName(protoreflect.Name(name))
+	if fd == nil {
+		fd = fields.ByJSONName(name)
+	}
+
+	if fd == nil {
+		return nil, fmt.Errorf("%w: %v", errUnknownField, name)
+	}
+
+	return fd, nil
+}
+
+// setField sets the field at the dot separated path of the message from the string values.
+func setField(msg protoreflect.Message, path string, values []string) error {
+	names := strings.Split(path, ".")
+
+	for _, name := range names[:len(names)-1] {
+		fd, err := lookupField(msg, name)
+		if err != nil {
+			return err
+		}
+
+		if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+			return fmt.Errorf("%w: %v", errUnsupportedField, path)
+		}
+
+		msg = msg.Mutable(fd).Message()
+	}
+
+	fd, err := lookupField(msg, names[len(names)-1])
+	if err != nil {
+		return err
+	}
+
+	if fd.IsMap() {
+		return fmt.Errorf("%w: %v", errUnsupportedField, path)
+	}
+
+	if !fd.IsList() {
+		value, err := parseFieldValue(msg, fd, values[len(values)-1])
+		if err != nil {
+			return err
+		}
+
+		msg.Set(fd, value)
+
+		return nil
+	}
+
+	list := msg.Mutable(fd).List()
+
+	for _, v := range values {
+		value, err := parseFieldValue(msg, fd, v)
+		if err != nil {
+			return err
+		}
+
+		list.Append(value)
+	}
+
+	return nil
+}
+
+//nolint:gocyclo,exhaustive // the value is parsed as per the kind of the field, other kinds are not supported.
+func parseFieldValue(msg protoreflect.Message, fd protoreflect.FieldDescriptor, value string) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(value), nil
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(value)
+		return protoreflect.ValueOfBool(b), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		i, err := strconv.ParseInt(value, 10, 32)
+		return protoreflect.ValueOfInt32(int32(i)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		i, err := strconv.ParseInt(value, 10, 64)
+		return protoreflect.ValueOfInt64(i), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		u, err := strconv.ParseUint(value, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(u)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		u, err := strconv.ParseUint(value, 10, 64)
+		return protoreflect.ValueOfUint64(u), err
+	case protoreflect.FloatKind:
+		f, err := strconv.ParseFloat(value, 32)
+		return protoreflect.ValueOfFloat32(float32(f)), err
+	case protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(value, 64)
+		return protoreflect.ValueOfFloat64(f), err
+	case protoreflect.BytesKind:
+		b, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			b, err = base64.URLEncoding.DecodeString(value)
+		}
+
+		return protoreflect.ValueOfBytes(b), err
+	case protoreflect.EnumKind:
+		if enumValue := fd.Enum().Values().ByName(protoreflect.Name(value)); enumValue != nil {
+			return protoreflect.ValueOfEnum(enumValue.Number()), nil
+		}
+
+		i, err := strconv.ParseInt(value, 10, 32)
+
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(i)), err
+	case protoreflect.MessageKind:
+		// well known types such as Timestamp, Duration and wrappers are decoded from their JSON representation.
+		field := msg.NewField(fd).Message()
+
+		if err := protojson.Unmarshal([]byte(strconv.Quote(value)), field.Interface()); err != nil {
+			if err := protojson.Unmarshal([]byte(value), field.Interface()); err != nil {
+				return protoreflect.Value{}, err
+			}
+		}
+
+		return protoreflect.ValueOfMessage(field), nil
+	default:
+		return protoreflect.Value{}, fmt.Errorf("%w: %v", errUnsupportedField, fd.FullName())
+	}
+}
@@ -0,0 +1,337 @@
+package gofr
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"gofr.dev/pkg/gofr/config"
+	"gofr.dev/pkg/gofr/container"
+	gofrHTTP "gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/testutil"
+)
+
+const transcodingTestService = "transcodingtest.Greeter"
+
+// transcodingTestFile registers, once, a Greeter service whose Greet method is annotated with google.api.http
+// and whose Echo method is not.
+var transcodingTestFile = func() protoreflect.FileDescriptor {
+	greetOptions := &descriptorpb.MethodOptions{}
+	proto.SetExtension(greetOptions, annotations.E_Http, &annotations.HttpRule{
+		Pattern: &annotations.HttpRule_Get{Get: "/v1/greet/{name}"},
+		AdditionalBindings: []*annotations.HttpRule{{
+			Pattern:      &annotations.HttpRule_Post{Post: "/v1/greet/{name}/inner"},
+			Body:         "inner",
+			ResponseBody: "inner",
+		}},
+	})
+
+	field := func(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type,
+		label descriptorpb.FieldDescriptorProto_Label, typeName string) *descriptorpb.FieldDescriptorProto {
+		f := &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String(name),
+			Number: proto.Int32(number),
+			Type:   typ.Enum(),
+			Label:  label.Enum(),
+		}
+
+		if typeName != "" {
+			f.TypeName = proto.String(typeName)
+		}
+
+		return f
+	}
+
+	optional := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+	repeated := descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+
+	file := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("transcodingtest/greeter.proto"),
+		Package:    proto.String("transcodingtest"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"google/api/annotations.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name:  proto.String("Inner"),
+				Field: []*descriptorpb.FieldDescriptorProto{field("value", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, optional, "")},
+			},
+			{
+				Name: proto.String("GreetRequest"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("name", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, optional, ""),
+					field("count", 2, descriptorpb.FieldDescriptorProto_TYPE_INT32, optional, ""),
+					field("inner", 3, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, optional, ".transcodingtest.Inner"),
+					field("tags", 4, descriptorpb.FieldDescriptorProto_TYPE_STRING, repeated, ""),
+				},
+			},
+		},
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name: proto.String("Greeter"),
+			Method: []*descriptorpb.MethodDescriptorProto{
+				{
+					Name:       proto.String("Greet"),
+					InputType:  proto.String(".transcodingtest.GreetRequest"),
+					OutputType: proto.String(".transcodingtest.GreetRequest"),
+					Options:    greetOptions,
+				},
+				{
+					Name:       proto.String("Echo"),
+					InputType:  proto.String(".transcodingtest.GreetRequest"),
+					OutputType: proto.String(".transcodingtest.GreetRequest"),
+				},
+			},
+		}},
+	}
+
+	fd, err := protodesc.NewFile(file, protoregistry.GlobalFiles)
+	if err != nil {
+		panic(err)
+	}
+
+	if err := protoregistry.GlobalFiles.RegisterFile(fd); err != nil {
+		panic(err)
+	}
+
+	return fd
+}()
+
+// transcodingTestServiceDesc echoes the request of the methods back, failing with NotFound for the name "missing".
+func transcodingTestServiceDesc() *grpc.ServiceDesc {
+	input := transcodingTestFile.Messages().ByName("GreetRequest")
+
+	greet := func(ctx context.Context, in any) (any, error) {
+		req := in.(*dynamicpb.Message)
+
+		if req.Get(input.Fields().ByName("name")).String() == "missing" {
+			return nil, status.Error(codes.NotFound, "greeting not found")
+		}
+
+		if md, _ := metadata.FromIncomingContext(ctx); len(md.Get("x-tag")) > 0 {
+			list := req.Mutable(input.Fields().ByName("tags")).List()
+			list.Append(protoreflect.ValueOfString(md.Get("x-tag")[0]))
+		}
+
+		return req, nil
+	}
+
+	// handler calls the interceptor in the same way as the handlers generated by protoc-gen-go-grpc.
+	handler := func(method string) func(any, context.Context, func(any) error, grpc.UnaryServerInterceptor) (any, error) {
+		return func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+			req := dynamicpb.NewMessage(input)
+			if err := dec(req); err != nil {
+				return nil, err
+			}
+
+			if interceptor == nil {
+				return greet(ctx, req)
+			}
+
+			info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + transcodingTestService + "/" + method}
+
+			return interceptor(ctx, req, info, greet)
+		}
+	}
+
+	return &grpc.ServiceDesc{
+		ServiceName: transcodingTestService,
+		HandlerType: (*any)(nil),
+		Methods: []grpc.MethodDesc{
+			{MethodName: "Greet", Handler: handler("Greet")},
+			{MethodName: "Echo", Handler: handler("Echo")},
+		},
+	}
+}
+
+func newTranscodingTestApp(t *testing.T) *App {
+	t.Helper()
+
+	app := &App{
+		Config: config.NewMockConfig(nil),
+		httpServer: &httpServer{
+			router: gofrHTTP.NewRouter(),
+			port:   testutil.GetFreePort(t),
+		},
+		container:  container.NewContainer(config.NewMockConfig(nil)),
+		grpcServer: &grpcServer{},
+	}
+
+	app.registerTranscodedService(transcodingTestServiceDesc(), nil)
+
+	return app
+}
+
+func TestApp_registerTranscodedService(t *testing.T) {
+	app := newTranscodingTestApp(t)
+
+	testCases := []struct {
+		desc       string
+		method     string
+		target     string
+		body       string
+		header     http.Header
+		statusCode int
+		response   string
+	}{
+		{desc: "path and query parameters", method: http.MethodGet, target: "/v1/greet/gofr?count=2&tags=a&tags=b&inner.value=x",
+			statusCode: http.StatusOK, response: `{"name":"gofr","count":2,"inner":{"value":"x"},"tags":["a","b"]}`},
+		{desc: "unknown query parameters are ignored", method: http.MethodGet, target: "/v1/greet/gofr?unknown=1",
+			statusCode: http.StatusOK, response: `{"name":"gofr"}`},
+		{desc: "headers as metadata", method: http.MethodGet, target: "/v1/greet/gofr", header: http.Header{"X-Tag": {"h"}},
+			statusCode: http.StatusOK, response: `{"name":"gofr","tags":["h"]}`},
+		{desc: "body and response body fields", method: http.MethodPost, target: "/v1/greet/gofr/inner", body: `{"value":"v"}`,
+			statusCode: http.StatusOK, response: `{"value":"v"}`},
+		{desc: "default mapping", method: http.MethodPost, target: "/transcodingtest.Greeter/Echo",
+			body: `{"name":"gofr","count":3,"extra":true}`, statusCode: http.StatusOK, response: `{"name":"gofr","count":3}`},
+		{desc: "invalid query parameter", method: http.MethodGet, target: "/v1/greet/gofr?count=abc",
+			statusCode: http.StatusBadRequest, response: `{"error":{"code":"InvalidArgument",` +
+				`"message":"invalid query parameter \"count\": strconv.ParseInt: parsing \"abc\": invalid syntax"}}`},
+		{desc: "invalid body", method: http.MethodPost, target: "/transcodingtest.Greeter/Echo", body: `{"count":"x"}`,
+			statusCode: http.StatusBadRequest},
+		{desc: "status of the error", method: http.MethodGet, target: "/v1/greet/missing",
+			statusCode: http.StatusNotFound, response: `{"error":{"code":"NotFound","message":"greeting not found"}}`},
+	}
+
+	for i, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, tc.target, strings.NewReader(tc.body))
+			for key, values := range tc.header {
+				req.Header[key] = values
+			}
+
+			w := httptest.NewRecorder()
+
+			app.httpServer.router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.statusCode, w.Code, "TEST[%d], Failed.\n%s", i, tc.desc)
+			assert.Equal(t, "application/json", w.Header().Get("Content-Type"), "TEST[%d], Failed.\n%s", i, tc.desc)
+
+			if tc.response != "" {
+				assert.JSONEq(t, tc.response, w.Body.String(), "TEST[%d], Failed.\n%s", i, tc.desc)
+			}
+		})
+	}
+}
+
+func TestApp_registerTranscodedService_Interceptors(t *testing.T) {
+	var methods []string
+
+	app := &App{
+		Config:     config.NewMockConfig(nil),
+		httpServer: &httpServer{router: gofrHTTP.NewRouter(), port: testutil.GetFreePort(t)},
+		container:  container.NewContainer(config.NewMockConfig(nil)),
+	}
+	app.grpcServer = &grpcServer{
+		config: config.NewMockConfig(nil),
+		interceptors: []grpc.UnaryServerInterceptor{
+			func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+				methods = append(methods, info.FullMethod)
+
+				if md, _ := metadata.FromIncomingContext(ctx); len(md.Get("x-deny")) > 0 {
+					return nil, status.Error(codes.PermissionDenied, "denied")
+				}
+
+				return handler(ctx, req)
+			},
+		},
+	}
+
+	require.NoError(t, app.grpcServer.createServer())
+
+	app.registerTranscodedService(transcodingTestServiceDesc(), nil)
+
+	w := httptest.NewRecorder()
+	app.httpServer.router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/greet/gofr", http.NoBody))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	req := httptest.NewRequest(http.MethodPost, "/transcodingtest.Greeter/Echo", strings.NewReader(`{"name":"gofr"}`))
+	req.Header.Set("X-Deny", "true")
+
+	w = httptest.NewRecorder()
+	app.httpServer.router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Equal(t, []string{"/transcodingtest.Greeter/Greet", "/transcodingtest.Greeter/Echo"}, methods)
+}
+
+func TestApp_registerTranscodedService_UnregisteredDescriptor(t *testing.T) {
+	app := newTranscodingTestApp(t)
+
+	desc := transcodingTestServiceDesc()
+	desc.ServiceName = "transcodingtest.Unregistered"
+
+	app.registerTranscodedService(desc, nil)
+
+	w := httptest.NewRecorder()
+	app.httpServer.router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/transcodingtest.Unregistered/Greet",
+		strings.NewReader(`{"name":"gofr"}`)))
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp map[string]any
+
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "gofr", resp["name"])
+}
+
+func TestRouterPattern(t *testing.T) {
+	testCases := []struct {
+		template string
+		pattern  string
+		fields   map[string]string
+		err      error
+	}{
+		{template: "/v1/books", pattern: "/v1/books", fields: map[string]string{}},
+		{template: "/v1/books/{id}", pattern: "/v1/books/{v0:[^/]+}", fields: map[string]string{"v0": "id"}},
+		{template: "/v1/{name=shelves/*}/books/{book.id}", pattern: "/v1/{v0:shelves/[^/]+}/books/{v1:[^/]+}",
+			fields: map[string]string{"v0": "name", "v1": "book.id"}},
+		{template: "/v1/{path=files/**}", pattern: "/v1/{v0:files/.+}", fields: map[string]string{"v0": "path"}},
+		{template: "/v1/{id", err: errInvalidPathTemplate},
+	}
+
+	for i, tc := range testCases {
+		pattern, fields, err := routerPattern(tc.template)
+
+		require.ErrorIs(t, err, tc.err, "TEST[%d], Failed.\n%s", i, tc.template)
+		assert.Equal(t, tc.pattern, pattern, "TEST[%d], Failed.\n%s", i, tc.template)
+
+		if tc.err == nil {
+			assert.Equal(t, tc.fields, fields, "TEST[%d], Failed.\n%s", i, tc.template)
+		}
+	}
+}
+
+func TestSetField(t *testing.T) {
+	msg := dynamicpb.NewMessage(transcodingTestFile.Messages().ByName("GreetRequest"))
+
+	require.NoError(t, setField(msg, "name", []string{"a", "b"}))
+	require.NoError(t, setField(msg, "inner.value", []string{"x"}))
+	require.NoError(t, setField(msg, "tags", []string{"a", "b"}))
+	require.ErrorIs(t, setField(msg, "unknown", []string{"x"}), errUnknownField)
+	require.ErrorIs(t, setField(msg, "tags.value", []string{"x"}), errUnsupportedField)
+	require.Error(t, setField(msg, "count", []string{"1.5"}))
+
+	fields := msg.Descriptor().Fields()
+
+	assert.Equal(t, "b", msg.Get(fields.ByName("name")).String())
+	assert.Equal(t, "x", msg.Get(fields.ByName("inner")).Message().Get(
+		fields.ByName("inner").Message().Fields().ByName("value")).String())
+	assert.Equal(t, 2, msg.Get(fields.ByName("tags")).List().Len())
+}
@@ -0,0 +1,184 @@
+package gofr
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc"
+)
+
+const (
+	grpcWebContentType     = "application/grpc-web"
+	grpcWebTextContentType = "application/grpc-web-text"
+	// grpcWebTrailerFlag marks the frame of the response body carrying the trailers.
+	grpcWebTrailerFlag = 0x80
+	grpcWebFrameHeader = 5
+	http2TrailerPrefix = "Trailer:"
+)
+
+// registerGRPCWebService serves the methods of the gRPC service to gRPC-Web clients, such as browsers, on the
+// HTTP server. The calls are served by the gRPC server, going through its interceptors.
+func (a *App) registerGRPCWebService(desc *grpc.ServiceDesc) {
+	a.enableHTTPServer()
+
+	a.httpServer.router.NewRoute().
+		Methods(http.MethodPost).
+		Path("/" + desc.ServiceName + "/{method}").
+		MatcherFunc(func(r *http.Request, _ *mux.RouteMatch) bool { return isGRPCWebRequest(r) }).
+		Handler(&grpcWebHandler{server: a.grpcServer.server})
+
+	a.container.Debugf("serving gRPC-Web calls of %s at /%s/", desc.ServiceName, desc.ServiceName)
+}
+
+func isGRPCWebRequest(r *http.Request) bool {
+	return strings.HasPrefix(r.Header.Get("Content-Type"), grpcWebContentType)
+}
+
+// grpcWebHandler converts the gRPC-Web calls to gRPC calls served by the gRPC server. The messages are framed
+// in the same way by both the protocols, while the trailers of gRPC-Web are sent in the last frame of the body.
+// In the text mode, the request and response bodies are base64 encoded.
+type grpcWebHandler struct {
+	server *grpc.Server
+}
+
+func (g *grpcWebHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	contentType := r.Header.Get("Content-Type")
+	text := strings.HasPrefix(contentType, grpcWebTextContentType)
+
+	subtype := strings.TrimPrefix(contentType, grpcWebTextContentType)
+	if !text {
+		subtype = strings.TrimPrefix(contentType, grpcWebContentType)
+	}
+
+	req := r.Clone(r.Context())
+	req.ProtoMajor, req.ProtoMinor, req.Proto = 2, 0, "HTTP/2"
+	req.Header.Set("Content-Type", "application/grpc"+subtype)
+	req.Header.Del("Content-Length")
+	req.ContentLength = -1
+
+	if text {
+		req.Body = io.NopCloser(base64.NewDecoder(base64.StdEncoding, r.Body))
+	}
+
+	responseType := grpcWebContentType
+	if text {
+		responseType = grpcWebTextContentType
+	}
+
+	writer := &grpcWebResponseWriter{
+		ResponseWriter: w,
+		header:         make(http.Header),
+		contentType:    responseType + subtype,
+		text:           text,
+	}
+
+	g.server.ServeHTTP(writer, req)
+
+	writer.writeTrailers()
+}
+
+// grpcWebResponseWriter writes the response of the gRPC server in the gRPC-Web format.
+type grpcWebResponseWriter struct {
+	http.ResponseWriter
+
+	header        http.Header
+	contentType   string
+	text          bool
+	headerWritten bool
+}
+
+func (w *grpcWebResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *grpcWebResponseWriter) WriteHeader(int) {
+	if w.headerWritten {
+		return
+	}
+
+	w.headerWritten = true
+
+	header := w.ResponseWriter.Header()
+
+	for key, values := range w.header {
+		if key == "Trailer" || strings.HasPrefix(key, http2TrailerPrefix) {
+			continue
+		}
+
+		header[key] = values
+	}
+
+	header.Set("Content-Type", w.contentType)
+	header.Set("Access-Control-Expose-Headers", "Grpc-Status, Grpc-Message, Grpc-Status-Details-Bin")
+	header.Del("Content-Length")
+
+	w.ResponseWriter.WriteHeader(http.StatusOK)
+}
+
+func (w *grpcWebResponseWriter) Write(b []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+
+	if !w.text {
+		return w.ResponseWriter.Write(b)
+	}
+
+	if _, err := w.ResponseWriter.Write([]byte(base64.StdEncoding.EncodeToString(b))); err != nil {
+		return 0, err
+	}
+
+	return len(b), nil
+}
+
+func (w *grpcWebResponseWriter) Flush() {
+	w.WriteHeader(http.StatusOK)
+
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// writeTrailers writes the trailers set by the gRPC server, e.g. grpc-status, as the last frame of the body.
+func (w *grpcWebResponseWriter) writeTrailers() {
+	trailers := make(http.Header)
+
+	for _, key := range w.header.Values("Trailer") {
+		if values, ok := w.header[http.CanonicalHeaderKey(key)]; ok {
+			trailers[key] = values
+		}
+	}
+
+	for key, values := range w.header {
+		if name, ok := strings.CutPrefix(key, http2TrailerPrefix); ok {
+			trailers[name] = append(trailers[name], values...)
+		}
+	}
+
+	keys := make([]string, 0, len(trailers))
+	for key := range trailers {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	var body bytes.Buffer
+
+	for _, key := range keys {
+		for _, v := range trailers[key] {
+			body.WriteString(strings.ToLower(key) + ": " + v + "\r\n")
+		}
+	}
+
+	frame := make([]byte, grpcWebFrameHeader, grpcWebFrameHeader+body.Len())
+	frame[0] = grpcWebTrailerFlag
+	binary.BigEndian.PutUint32(frame[1:], uint32(body.Len())) //nolint:gosec // the trailers are far smaller than 4GB.
+	frame = append(frame, body.Bytes()...)
+
+	_, _ = w.Write(frame)
+	w.Flush()
+}
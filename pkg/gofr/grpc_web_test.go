@@ -0,0 +1,169 @@
+package gofr
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/proto"
+
+	"gofr.dev/pkg/gofr/config"
+	"gofr.dev/pkg/gofr/container"
+	gofrHTTP "gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/testutil"
+)
+
+func newGRPCWebTestApp(t *testing.T) *App {
+	t.Helper()
+
+	c := container.NewContainer(config.NewMockConfig(nil))
+
+	g, err := newGRPCServer(c, testutil.GetFreePort(t), config.NewMockConfig(nil))
+	require.NoError(t, err)
+	require.NoError(t, g.createServer())
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("hello.Hello", grpc_health_v1.HealthCheckResponse_SERVING)
+	grpc_health_v1.RegisterHealthServer(g.server, healthServer)
+
+	app := &App{
+		httpServer: &httpServer{router: gofrHTTP.NewRouter(), port: testutil.GetFreePort(t)},
+		grpcServer: g,
+		container:  c,
+	}
+
+	app.registerGRPCWebService(&grpc_health_v1.Health_ServiceDesc)
+
+	return app
+}
+
+// grpcWebFrames splits the body of a gRPC-Web response into its messages and trailers.
+func grpcWebFrames(t *testing.T, body []byte) (messages [][]byte, trailers string) {
+	t.Helper()
+
+	for len(body) > 0 {
+		require.GreaterOrEqual(t, len(body), grpcWebFrameHeader)
+
+		length := int(binary.BigEndian.Uint32(body[1:grpcWebFrameHeader]))
+		frame := body[grpcWebFrameHeader : grpcWebFrameHeader+length]
+
+		if body[0]&grpcWebTrailerFlag != 0 {
+			trailers = string(frame)
+		} else {
+			messages = append(messages, frame)
+		}
+
+		body = body[grpcWebFrameHeader+length:]
+	}
+
+	return messages, trailers
+}
+
+func grpcWebRequestBody(t *testing.T, msg proto.Message) []byte {
+	t.Helper()
+
+	data, err := proto.Marshal(msg)
+	require.NoError(t, err)
+
+	frame := make([]byte, grpcWebFrameHeader, grpcWebFrameHeader+len(data))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(data)))
+
+	return append(frame, data...)
+}
+
+func TestGRPCWeb(t *testing.T) {
+	app := newGRPCWebTestApp(t)
+
+	testCases := []struct {
+		desc        string
+		contentType string
+		service     string
+		status      grpc_health_v1.HealthCheckResponse_ServingStatus
+		trailers    string
+	}{
+		{desc: "binary mode", contentType: "application/grpc-web+proto", service: "hello.Hello",
+			status: grpc_health_v1.HealthCheckResponse_SERVING, trailers: "grpc-status: 0\r\n"},
+		{desc: "text mode", contentType: "application/grpc-web-text", service: "hello.Hello",
+			status: grpc_health_v1.HealthCheckResponse_SERVING, trailers: "grpc-status: 0\r\n"},
+		{desc: "error status", contentType: "application/grpc-web", service: "unknown",
+			trailers: "grpc-message: unknown service\r\ngrpc-status: 5\r\n"},
+	}
+
+	for i, tc := range testCases {
+		body := grpcWebRequestBody(t, &grpc_health_v1.HealthCheckRequest{Service: tc.service})
+		text := tc.contentType == grpcWebTextContentType
+
+		if text {
+			body = []byte(base64.StdEncoding.EncodeToString(body))
+		}
+
+		req := httptest.NewRequest(http.MethodPost, "/grpc.health.v1.Health/Check", bytes.NewReader(body))
+		req.Header.Set("Content-Type", tc.contentType)
+
+		w := httptest.NewRecorder()
+
+		app.httpServer.router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.contentType, w.Header().Get("Content-Type"), "TEST[%d], Failed.\n%s", i, tc.desc)
+
+		respBody := w.Body.Bytes()
+
+		if text {
+			respBody = decodeGRPCWebText(t, w.Body.String())
+		}
+
+		messages, trailers := grpcWebFrames(t, respBody)
+		assert.Equal(t, tc.trailers, trailers, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+		if tc.status == grpc_health_v1.HealthCheckResponse_UNKNOWN {
+			assert.Empty(t, messages, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+			continue
+		}
+
+		require.Len(t, messages, 1, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+		var resp grpc_health_v1.HealthCheckResponse
+
+		require.NoError(t, proto.Unmarshal(messages[0], &resp))
+		assert.Equal(t, tc.status, resp.GetStatus(), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+// decodeGRPCWebText decodes the base64 chunks written for each write of the response, in groups of 4 characters
+// as the gRPC-Web clients do.
+func decodeGRPCWebText(t *testing.T, s string) []byte {
+	t.Helper()
+
+	var decoded []byte
+
+	for ; s != ""; s = s[4:] {
+		chunk, err := base64.StdEncoding.DecodeString(s[:4])
+		require.NoError(t, err)
+
+		decoded = append(decoded, chunk...)
+	}
+
+	return decoded
+}
+
+func TestGRPCWeb_NotGRPCWebRequest(t *testing.T) {
+	app := newGRPCWebTestApp(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/grpc.health.v1.Health/Check", http.NoBody)
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+
+	app.httpServer.router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
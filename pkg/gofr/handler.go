@@ -17,6 +17,7 @@ import (
 	gofrHTTP "gofr.dev/pkg/gofr/http"
 	"gofr.dev/pkg/gofr/http/response"
 	"gofr.dev/pkg/gofr/logging"
+	"gofr.dev/pkg/gofr/service"
 	"gofr.dev/pkg/gofr/static"
 )
 
@@ -60,8 +61,8 @@ func (h handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if websocket.IsWebSocketUpgrade(r) {
 		// If the request is a WebSocket upgrade, do not apply the timeout
 		c.Context = r.Context()
-	} else if h.requestTimeout != 0 {
-		ctx, cancel := context.WithTimeout(r.Context(), h.requestTimeout)
+	} else if timeout := h.timeout(r); timeout != 0 {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
 		defer cancel()
 
 		c.Context = ctx
@@ -112,8 +113,35 @@ func (h handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	c.responder.Respond(result, err)
 }
 
-func healthHandler(c *Context) (any, error) {
-	return c.Health(c), nil
+// timeout returns the time within which the request is to be served, shortening the configured request timeout
+// to the time left for the caller, when propagated by it.
+func (h handler) timeout(r *http.Request) time.Duration {
+	callerTimeout, ok := service.ParseTimeoutHeader(r)
+	if !ok || (h.requestTimeout != 0 && h.requestTimeout < callerTimeout) {
+		return h.requestTimeout
+	}
+
+	return callerTimeout
+}
+
+// healthHandler reports the health of the datasources and services of the app, along with the status of its
+// subscriptions. The app is degraded when any of its subscriptions is down.
+func (a *App) healthHandler(c *Context) (any, error) {
+	health := c.Health(c)
+
+	healthMap, ok := health.(map[string]any)
+	if !ok || len(a.subscriptionManager.subscriptions) == 0 {
+		return health, nil
+	}
+
+	subscriptions, downCount := a.subscriptionManager.health()
+	healthMap["subscriptions"] = subscriptions
+
+	if downCount > 0 {
+		healthMap["status"] = "DEGRADED"
+	}
+
+	return healthMap, nil
 }
 
 func liveHandler(*Context) (any, error) {
@@ -23,6 +23,7 @@ import (
 	gofrHTTP "gofr.dev/pkg/gofr/http"
 	"gofr.dev/pkg/gofr/http/response"
 	"gofr.dev/pkg/gofr/logging"
+	"gofr.dev/pkg/gofr/service"
 	"gofr.dev/pkg/gofr/testutil"
 )
 
@@ -89,6 +90,53 @@ func TestHandler_ServeHTTP_Timeout(t *testing.T) {
 	assert.Contains(t, w.Body.String(), "request timed out", "TestHandler_ServeHTTP_Timeout Failed")
 }
 
+func TestHandler_ServeHTTP_CallerTimeout(t *testing.T) {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+	r.Header.Set(service.TimeoutHeader, "50")
+
+	h := handler{requestTimeout: 5 * time.Second}
+
+	h.container = &container.Container{Logger: logging.NewLogger(logging.FATAL)}
+	h.function = func(*Context) (any, error) {
+		time.Sleep(200 * time.Millisecond)
+
+		return "hey", nil
+	}
+
+	h.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusRequestTimeout, w.Code)
+	assert.Contains(t, w.Body.String(), "request timed out")
+}
+
+func TestHandler_timeout(t *testing.T) {
+	testCases := []struct {
+		desc           string
+		header         string
+		requestTimeout time.Duration
+		expected       time.Duration
+	}{
+		{"no caller timeout", "", 5 * time.Second, 5 * time.Second},
+		{"caller timeout shorter than request timeout", "2000", 5 * time.Second, 2 * time.Second},
+		{"caller timeout longer than request timeout", "8000", 5 * time.Second, 5 * time.Second},
+		{"caller timeout without request timeout", "300", 0, 300 * time.Millisecond},
+		{"invalid caller timeout", "soon", 5 * time.Second, 5 * time.Second},
+		{"negative caller timeout", "-1", 0, 0},
+	}
+
+	for i, tc := range testCases {
+		r := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+		if tc.header != "" {
+			r.Header.Set(service.TimeoutHeader, tc.header)
+		}
+
+		timeout := handler{requestTimeout: tc.requestTimeout}.timeout(r)
+
+		assert.Equal(t, tc.expected, timeout, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
 func TestHandler_ServeHTTP_Panic(t *testing.T) {
 	w := httptest.NewRecorder()
 	r := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
@@ -259,7 +307,7 @@ func TestHandler_healthHandler(t *testing.T) {
 
 	ctx := newContext(nil, r, a.container)
 
-	h, err := healthHandler(ctx)
+	h, err := a.healthHandler(ctx)
 
 	require.NoError(t, err)
 	assert.NotNil(t, h)
@@ -136,15 +136,20 @@ type PublicKeyProvider interface {
 
 // OAuth is a middleware function that validates JWT access tokens using a provided PublicKeyProvider.
 func OAuth(key PublicKeyProvider, options ...jwt.ParserOption) func(http.Handler) http.Handler {
-	// error being ignored is not the right behavior, this function should be deprecated and use NewOAuthProvider() instead.
+	return AuthMiddleware(NewOAuthProviderWithKeys(key, options...))
+}
+
+// NewOAuthProviderWithKeys generates an OAuthProvider validating JWT access tokens using the given PublicKeyProvider,
+// so that the same public keys can be used to authenticate the requests to both the HTTP and gRPC servers.
+func NewOAuthProviderWithKeys(key PublicKeyProvider, options ...jwt.ParserOption) AuthProvider {
+	// error being ignored is not the right behavior, a nil provider fails the validation of every token.
 	function, _ := getPublicKeyFunc(key)
-	provider := OAuthProvider{
+
+	return &OAuthProvider{
 		publicKeyFunc: function,
 		options:       append(options, jwt.WithIssuedAt()),
 		regex:         regexp.MustCompile(jwtRegexPattern),
 	}
-
-	return AuthMiddleware(&provider)
 }
 
 // JWKS represents a JSON Web Key Set.
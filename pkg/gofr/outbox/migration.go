@@ -0,0 +1,93 @@
+package outbox
+
+import (
+	"errors"
+	"fmt"
+
+	"gofr.dev/pkg/gofr/migration"
+)
+
+var errUnknownDialect = errors.New("unable to find the dialect of the SQL datasource of the migration")
+
+const (
+	createTableMySQL = `CREATE TABLE IF NOT EXISTS %s (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    aggregate_id VARCHAR(255) NOT NULL,
+    topic VARCHAR(255) NOT NULL,
+    message_key VARCHAR(255),
+    payload LONGBLOB,
+    headers TEXT,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    published_at TIMESTAMP NULL,
+    INDEX %s_pending (published_at, id),
+    INDEX %s_aggregate (aggregate_id, id)
+);`
+
+	createTablePostgres = `CREATE TABLE IF NOT EXISTS %s (
+    id BIGSERIAL PRIMARY KEY,
+    aggregate_id VARCHAR(255) NOT NULL,
+    topic VARCHAR(255) NOT NULL,
+    message_key VARCHAR(255),
+    payload BYTEA,
+    headers TEXT,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    published_at TIMESTAMP NULL
+);`
+
+	createTableSQLite = `CREATE TABLE IF NOT EXISTS %s (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    aggregate_id VARCHAR(255) NOT NULL,
+    topic VARCHAR(255) NOT NULL,
+    message_key VARCHAR(255),
+    payload BLOB,
+    headers TEXT,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    published_at TIMESTAMP NULL
+);`
+
+	createIndex          = `CREATE INDEX IF NOT EXISTS %s_pending ON %s (published_at, id);`
+	createAggregateIndex = `CREATE INDEX IF NOT EXISTS %s_aggregate ON %s (aggregate_id, id);`
+)
+
+// CreateTable creates the outbox table and its indexes, if they do not exist. It is a migration.MigrateFunc,
+// to be registered as a migration of the app:
+//
+//	app.Migrate(map[int64]migration.Migrate{
+//		20240101000000: {UP: outbox.CreateTable},
+//	})
+func CreateTable(d migration.Datasource) error {
+	db, ok := d.SQL.(interface{ Dialect() string })
+	if !ok {
+		return errUnknownDialect
+	}
+
+	var queries []string
+
+	switch db.Dialect() {
+	case "mysql":
+		queries = []string{fmt.Sprintf(createTableMySQL, TableName, TableName, TableName)}
+	case "sqlite":
+		queries = []string{fmt.Sprintf(createTableSQLite, TableName)}
+	default:
+		queries = []string{fmt.Sprintf(createTablePostgres, TableName)}
+	}
+
+	if db.Dialect() != "mysql" {
+		queries = append(queries, fmt.Sprintf(createIndex, TableName, TableName),
+			fmt.Sprintf(createAggregateIndex, TableName, TableName))
+	}
+
+	for _, query := range queries {
+		if _, err := d.SQL.Exec(query); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
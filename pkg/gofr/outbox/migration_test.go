@@ -0,0 +1,49 @@
+package outbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	gofrSql "gofr.dev/pkg/gofr/datasource/sql"
+	"gofr.dev/pkg/gofr/migration"
+)
+
+func TestCreateTable(t *testing.T) {
+	testCases := []struct {
+		dialect string
+		queries []string
+	}{
+		{dialect: "mysql", queries: []string{fmt.Sprintf(createTableMySQL, TableName, TableName, TableName)}},
+		{dialect: "postgres", queries: []string{fmt.Sprintf(createTablePostgres, TableName),
+			"CREATE INDEX IF NOT EXISTS gofr_outbox_pending ON gofr_outbox (published_at, id);",
+			"CREATE INDEX IF NOT EXISTS gofr_outbox_aggregate ON gofr_outbox (aggregate_id, id);"}},
+		{dialect: "sqlite", queries: []string{fmt.Sprintf(createTableSQLite, TableName),
+			"CREATE INDEX IF NOT EXISTS gofr_outbox_pending ON gofr_outbox (published_at, id);",
+			"CREATE INDEX IF NOT EXISTS gofr_outbox_aggregate ON gofr_outbox (aggregate_id, id);"}},
+	}
+
+	for i, tc := range testCases {
+		db, mock, _ := gofrSql.NewSQLMocksWithConfig(t, &gofrSql.DBConfig{Dialect: tc.dialect})
+
+		for _, query := range tc.queries {
+			mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 0))
+		}
+
+		err := CreateTable(migration.Datasource{SQL: db})
+
+		require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.dialect)
+		require.NoError(t, mock.ExpectationsWereMet(), "TEST[%d], Failed.\n%s", i, tc.dialect)
+	}
+}
+
+func TestCreateTable_Errors(t *testing.T) {
+	db, mock, _ := gofrSql.NewSQLMocksWithConfig(t, &gofrSql.DBConfig{Dialect: "mysql"})
+
+	mock.ExpectExec(fmt.Sprintf(createTableMySQL, TableName, TableName, TableName)).WillReturnError(errDB)
+
+	require.ErrorIs(t, CreateTable(migration.Datasource{SQL: db}), errDB)
+	require.ErrorIs(t, CreateTable(migration.Datasource{}), errUnknownDialect)
+}
@@ -0,0 +1,108 @@
+// Package outbox provides a transactional outbox, to publish events atomically with the writes to an SQL database.
+// The events are inserted in the outbox table in the transaction of the writes, and published by a relay
+// running in the background, once the transaction is committed.
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+	gofrSql "gofr.dev/pkg/gofr/datasource/sql"
+)
+
+// TableName is the name of the outbox table, created by CreateTable.
+const TableName = "gofr_outbox"
+
+var (
+	errEmptyTopic       = errors.New("outbox event topic must not be empty")
+	errEmptyAggregateID = errors.New("outbox event aggregate id must not be empty")
+)
+
+// Event is an event to be published through the outbox.
+type Event struct {
+	// AggregateID identifies the entity the event is about, e.g. the id of an order. The events of an aggregate
+	// are published in the order they are added.
+	AggregateID string
+	Topic       string
+	// Key is the key of the published message. It defaults to the AggregateID.
+	Key     string
+	Payload []byte
+	Headers map[string]string
+}
+
+// Add inserts the events in the outbox table in the transaction, so that they are published by the relay
+// only if the transaction is committed. The trace context of ctx is added to the headers of the events,
+// so that the spans of the subscribers are linked to the trace of the transaction.
+func Add(ctx context.Context, tx *gofrSql.Tx, events ...Event) error {
+	query := fmt.Sprintf(`INSERT INTO %s (aggregate_id, topic, message_key, payload, headers, attempts, created_at)
+VALUES (%s)`, TableName, bindVars(tx.Dialect(), 1, 7))
+
+	for _, event := range events {
+		if event.Topic == "" {
+			return errEmptyTopic
+		}
+
+		if event.AggregateID == "" {
+			return errEmptyAggregateID
+		}
+
+		key := event.Key
+		if key == "" {
+			key = event.AggregateID
+		}
+
+		// the headers are stored as JSON, or NULL when there are none.
+		var headers any
+
+		if h := pubsub.InjectTraceContext(ctx, event.Headers); len(h) > 0 {
+			b, err := json.Marshal(h)
+			if err != nil {
+				return err
+			}
+
+			headers = string(b)
+		}
+
+		_, err := tx.ExecContext(ctx, query, event.AggregateID, event.Topic, key, event.Payload, headers, 0,
+			time.Now().UTC())
+		if err != nil {
+			return fmt.Errorf("inserting outbox event of topic %s: %w", event.Topic, err)
+		}
+	}
+
+	return nil
+}
+
+// isDollarDialect reports whether the bind variables of the dialect are $1, $2, ... instead of ?.
+func isDollarDialect(dialect string) bool {
+	switch dialect {
+	case "postgres", "supabase", "cockroachdb":
+		return true
+	default:
+		return false
+	}
+}
+
+// bindVars returns n comma separated bind variables of the dialect, starting at the position from.
+func bindVars(dialect string, from, n int) string {
+	vars := make([]string, n)
+
+	for i := range vars {
+		vars[i] = bindVar(dialect, from+i)
+	}
+
+	return strings.Join(vars, ", ")
+}
+
+func bindVar(dialect string, position int) string {
+	if isDollarDialect(dialect) {
+		return fmt.Sprintf("$%d", position)
+	}
+
+	return "?"
+}
@@ -0,0 +1,69 @@
+package outbox
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gofrSql "gofr.dev/pkg/gofr/datasource/sql"
+)
+
+var errDB = errors.New("db error")
+
+func TestAdd(t *testing.T) {
+	testCases := []struct {
+		dialect string
+		query   string
+	}{
+		{dialect: "mysql", query: `INSERT INTO gofr_outbox (aggregate_id, topic, message_key, payload, headers, attempts, created_at)
+VALUES (?, ?, ?, ?, ?, ?, ?)`},
+		{dialect: "postgres", query: `INSERT INTO gofr_outbox (aggregate_id, topic, message_key, payload, headers, attempts, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)`},
+	}
+
+	for i, tc := range testCases {
+		db, mock, _ := gofrSql.NewSQLMocksWithConfig(t, &gofrSql.DBConfig{Dialect: tc.dialect})
+
+		mock.ExpectBegin()
+		mock.ExpectExec(tc.query).WithArgs("order-1", "orders", "order-1", []byte(`{"id":1}`), `{"tenant":"gofr"}`, 0,
+			sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(tc.query).WithArgs("order-1", "payments", "payment-1", []byte(`{"id":2}`), nil, 0,
+			sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(2, 1))
+
+		tx, err := db.Begin()
+		require.NoError(t, err)
+
+		err = Add(t.Context(), tx,
+			Event{AggregateID: "order-1", Topic: "orders", Payload: []byte(`{"id":1}`), Headers: map[string]string{"tenant": "gofr"}},
+			Event{AggregateID: "order-1", Topic: "payments", Key: "payment-1", Payload: []byte(`{"id":2}`)},
+		)
+
+		require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.dialect)
+		require.NoError(t, mock.ExpectationsWereMet(), "TEST[%d], Failed.\n%s", i, tc.dialect)
+	}
+}
+
+func TestAdd_Errors(t *testing.T) {
+	db, mock, _ := gofrSql.NewSQLMocksWithConfig(t, &gofrSql.DBConfig{Dialect: "mysql"})
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO gofr_outbox (aggregate_id, topic, message_key, payload, headers, attempts, created_at)
+VALUES (?, ?, ?, ?, ?, ?, ?)`).WillReturnError(errDB)
+
+	tx, err := db.Begin()
+	require.NoError(t, err)
+
+	require.ErrorIs(t, Add(t.Context(), tx, Event{AggregateID: "order-1"}), errEmptyTopic)
+	require.ErrorIs(t, Add(t.Context(), tx, Event{Topic: "orders"}), errEmptyAggregateID)
+	require.ErrorIs(t, Add(t.Context(), tx, Event{AggregateID: "order-1", Topic: "orders"}), errDB)
+}
+
+func TestBindVars(t *testing.T) {
+	assert.Equal(t, "?, ?", bindVars("mysql", 1, 2))
+	assert.Equal(t, "?", bindVars("sqlite", 1, 1))
+	assert.Equal(t, "$2, $3", bindVars("postgres", 2, 2))
+	assert.Equal(t, "$1", bindVars("cockroachdb", 1, 1))
+}
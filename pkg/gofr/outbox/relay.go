@@ -0,0 +1,331 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+	gofrSql "gofr.dev/pkg/gofr/datasource/sql"
+)
+
+const (
+	defaultPollInterval    = time.Second
+	defaultBatchSize       = 100
+	defaultRetention       = 24 * time.Hour
+	defaultCleanupInterval = time.Hour
+	defaultMaxAttempts     = 10
+)
+
+// Headers added to the events published to the dead-letter topic, along with the headers of the event, as for the
+// messages dead-lettered by the subscriptions.
+const (
+	DeadLetterErrorHeader    = "x-dead-letter-error"
+	DeadLetterTopicHeader    = "x-dead-letter-topic"
+	DeadLetterAttemptsHeader = "x-dead-letter-attempts"
+)
+
+// Config configures the relay publishing the events of the outbox.
+type Config struct {
+	// PollInterval is the time to wait between the reads of the pending events. It defaults to 1 second.
+	PollInterval time.Duration
+	// BatchSize is the maximum number of pending events read at a time. It defaults to 100.
+	BatchSize int
+	// Retention is the time for which the published events are kept in the outbox table. It defaults to 24 hours.
+	Retention time.Duration
+	// CleanupInterval is the time to wait between the deletions of the published events older than Retention.
+	// It defaults to 1 hour.
+	CleanupInterval time.Duration
+	// MaxAttempts is the number of times the publishing of an event is attempted. An event which fails all its
+	// attempts is published to DeadLetterTopic when it is set, and parked otherwise, i.e. left unpublished along
+	// with the later events of its aggregate, till its attempts are reset in the outbox table. It defaults to 10.
+	MaxAttempts int
+	// DeadLetterTopic receives the events which fail all their attempts, with the error in the x-dead-letter-*
+	// headers. The event is then marked as published, so that the later events of its aggregate are published.
+	DeadLetterTopic string
+}
+
+// Relay publishes the pending events of the outbox through the publisher of the container, in the order they are
+// added. An event is marked as published only after it is published, so that it is published at least once.
+//
+// When the publishing of an event fails, it is retried in the next poll, while the later events of its aggregate
+// are not read till it is published, to keep the order of the events of the aggregate. The events of the other
+// aggregates are published meanwhile.
+type Relay struct {
+	container *container.Container
+	config    Config
+}
+
+// pendingEvent is an event of the outbox table which is not yet published.
+type pendingEvent struct {
+	id          int64
+	aggregateID string
+	topic       string
+	key         sql.NullString
+	payload     []byte
+	headers     sql.NullString
+	attempts    int
+}
+
+// NewRelay returns the relay of the outbox of the SQL datasource of the container, with the defaults set
+// for the missing values of the config.
+func NewRelay(c *container.Container, config Config) *Relay {
+	if config.PollInterval <= 0 {
+		config.PollInterval = defaultPollInterval
+	}
+
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaultBatchSize
+	}
+
+	if config.Retention <= 0 {
+		config.Retention = defaultRetention
+	}
+
+	if config.CleanupInterval <= 0 {
+		config.CleanupInterval = defaultCleanupInterval
+	}
+
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaultMaxAttempts
+	}
+
+	return &Relay{container: c, config: config}
+}
+
+// Run publishes the pending events every poll interval, and deletes the published events every cleanup interval,
+// until ctx is done.
+func (r *Relay) Run(ctx context.Context) {
+	r.container.Infof("starting outbox relay with poll interval %v", r.config.PollInterval)
+
+	poll := time.NewTicker(r.config.PollInterval)
+	defer poll.Stop()
+
+	cleanup := time.NewTicker(r.config.CleanupInterval)
+	defer cleanup.Stop()
+
+	for {
+		// the batches are read until the pending events are exhausted, or an event fails to be published.
+		for more := true; more && ctx.Err() == nil; {
+			more = r.relay(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			r.container.Infof("shutting down outbox relay")
+
+			return
+		case <-poll.C:
+		case <-cleanup.C:
+			r.cleanup(ctx)
+		}
+	}
+}
+
+// relay publishes a batch of the pending events. It reports whether more events may be pending, i.e. the batch
+// is full and all its events are published.
+func (r *Relay) relay(ctx context.Context) bool {
+	tx, err := r.container.SQL.Begin()
+	if err != nil {
+		r.container.Errorf("error beginning transaction of outbox relay: %v", err)
+
+		return false
+	}
+
+	dialect := tx.Dialect()
+
+	// the pending events are locked till the transaction ends, so that the relays of the other instances of the app
+	// wait for them to be published, keeping the order of the events. SQLite does not support the locking of rows.
+	// The parked events, and the events after a failed event of their aggregate, are not read, so that they do not
+	// fill the batches in place of the events of the other aggregates.
+	query := fmt.Sprintf(`SELECT id, aggregate_id, topic, message_key, payload, headers, attempts FROM %[1]s o
+WHERE o.published_at IS NULL AND o.attempts < %[2]s AND NOT EXISTS (SELECT 1 FROM %[1]s f
+WHERE f.aggregate_id = o.aggregate_id AND f.published_at IS NULL AND f.attempts > 0 AND f.id < o.id)
+ORDER BY o.id LIMIT %[3]s`, TableName, bindVar(dialect, 1), bindVar(dialect, 2))
+	if dialect != "sqlite" {
+		query += " FOR UPDATE"
+	}
+
+	events, err := readEvents(ctx, tx, query, r.config.MaxAttempts, r.config.BatchSize)
+	if err != nil {
+		r.container.Errorf("error reading pending outbox events: %v", err)
+
+		_ = tx.Rollback()
+
+		return false
+	}
+
+	publishedQuery := fmt.Sprintf(`UPDATE %s SET published_at = %s WHERE id = %s`, TableName,
+		bindVar(dialect, 1), bindVar(dialect, 2))
+	failedQuery := fmt.Sprintf(`UPDATE %s SET attempts = attempts + 1, last_error = %s WHERE id = %s`, TableName,
+		bindVar(dialect, 1), bindVar(dialect, 2))
+	deadLetteredQuery := fmt.Sprintf(`UPDATE %s SET attempts = attempts + 1, last_error = %s, published_at = %s WHERE id = %s`,
+		TableName, bindVar(dialect, 1), bindVar(dialect, 2), bindVar(dialect, 3))
+
+	// blocked are the aggregates with an event which failed to be published.
+	blocked := make(map[string]bool)
+
+	for _, event := range events {
+		if blocked[event.aggregateID] {
+			continue
+		}
+
+		if err := r.publish(ctx, event); err != nil {
+			r.container.Errorf("error publishing outbox event %d of topic %s: %v", event.id, event.topic, err)
+			r.container.Metrics().IncrementCounter(ctx, "app_outbox_publish_error_count", "topic", event.topic)
+
+			if r.deadLetter(ctx, event, err) {
+				if _, err := tx.ExecContext(ctx, deadLetteredQuery, err.Error(), time.Now().UTC(), event.id); err != nil {
+					r.container.Errorf("error marking outbox event %d as dead-lettered: %v", event.id, err)
+
+					_ = tx.Rollback()
+
+					return false
+				}
+
+				continue
+			}
+
+			blocked[event.aggregateID] = true
+
+			if _, err := tx.ExecContext(ctx, failedQuery, err.Error(), event.id); err != nil {
+				r.container.Errorf("error recording failure of outbox event %d: %v", event.id, err)
+			}
+
+			if event.attempts+1 >= r.config.MaxAttempts {
+				r.container.Errorf("outbox event %d of aggregate %s is parked after %d attempts, the later events of the "+
+					"aggregate are not published till its attempts are reset", event.id, event.aggregateID, r.config.MaxAttempts)
+				r.container.Metrics().IncrementCounter(ctx, "app_outbox_parked_count", "topic", event.topic)
+			}
+
+			continue
+		}
+
+		r.container.Metrics().IncrementCounter(ctx, "app_outbox_published_count", "topic", event.topic)
+
+		if _, err := tx.ExecContext(ctx, publishedQuery, time.Now().UTC(), event.id); err != nil {
+			// the events published in the transaction are published again, as they are not marked as published.
+			r.container.Errorf("error marking outbox event %d as published: %v", event.id, err)
+
+			_ = tx.Rollback()
+
+			return false
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.container.Errorf("error committing transaction of outbox relay: %v", err)
+
+		return false
+	}
+
+	return len(events) == r.config.BatchSize && len(blocked) == 0
+}
+
+func (r *Relay) publish(ctx context.Context, event pendingEvent) error {
+	headers, err := event.headerMap()
+	if err != nil {
+		return err
+	}
+
+	// the event is published in the trace of the handler which added it, stored in its headers by Add.
+	ctx = pubsub.ExtractTraceContext(ctx, headers)
+
+	return pubsub.PublishWithOptions(ctx, r.container.GetPublisher(), event.topic, event.payload, pubsub.PublishOptions{
+		Headers: headers,
+		Key:     event.key.String,
+	})
+}
+
+// deadLetter publishes the event, which failed to be published in its last attempt, to the dead-letter topic.
+// It reports whether the event is published, so that it can be marked as published.
+func (r *Relay) deadLetter(ctx context.Context, event pendingEvent, publishErr error) bool {
+	if r.config.DeadLetterTopic == "" || event.attempts+1 < r.config.MaxAttempts {
+		return false
+	}
+
+	// the event is dead-lettered with the headers added here when its own headers are invalid.
+	eventHeaders, _ := event.headerMap()
+
+	headers := make(map[string]string, len(eventHeaders)+3)
+	for k, v := range eventHeaders {
+		headers[k] = v
+	}
+
+	headers[DeadLetterErrorHeader] = publishErr.Error()
+	headers[DeadLetterTopicHeader] = event.topic
+	headers[DeadLetterAttemptsHeader] = strconv.Itoa(event.attempts + 1)
+
+	err := pubsub.PublishWithOptions(pubsub.ExtractTraceContext(ctx, eventHeaders), r.container.GetPublisher(),
+		r.config.DeadLetterTopic, event.payload, pubsub.PublishOptions{Headers: headers, Key: event.key.String})
+	if err != nil {
+		r.container.Errorf("error publishing outbox event %d of topic %s to dead-letter topic %s: %v", event.id,
+			event.topic, r.config.DeadLetterTopic, err)
+
+		return false
+	}
+
+	r.container.Metrics().IncrementCounter(ctx, "app_outbox_dead_letter_count",
+		"topic", event.topic, "dead_letter_topic", r.config.DeadLetterTopic)
+
+	return true
+}
+
+// headerMap returns the headers of the event, stored as JSON.
+func (e *pendingEvent) headerMap() (map[string]string, error) {
+	if !e.headers.Valid || e.headers.String == "" {
+		return nil, nil
+	}
+
+	var headers map[string]string
+
+	if err := json.Unmarshal([]byte(e.headers.String), &headers); err != nil {
+		return nil, fmt.Errorf("invalid headers: %w", err)
+	}
+
+	return headers, nil
+}
+
+// cleanup deletes the events published before the retention period.
+func (r *Relay) cleanup(ctx context.Context) {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE published_at IS NOT NULL AND published_at < %s`, TableName,
+		bindVar(r.container.SQL.Dialect(), 1))
+
+	res, err := r.container.SQL.ExecContext(ctx, query, time.Now().UTC().Add(-r.config.Retention))
+	if err != nil {
+		r.container.Errorf("error deleting published outbox events: %v", err)
+
+		return
+	}
+
+	if n, err := res.RowsAffected(); err == nil && n > 0 {
+		r.container.Debugf("deleted %d published outbox events", n)
+	}
+}
+
+func readEvents(ctx context.Context, tx *gofrSql.Tx, query string, args ...any) ([]pendingEvent, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var events []pendingEvent
+
+	for rows.Next() {
+		var e pendingEvent
+
+		if err := rows.Scan(&e.id, &e.aggregateID, &e.topic, &e.key, &e.payload, &e.headers, &e.attempts); err != nil {
+			return nil, err
+		}
+
+		events = append(events, e)
+	}
+
+	return events, rows.Err()
+}
@@ -0,0 +1,255 @@
+package outbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+	gofrSql "gofr.dev/pkg/gofr/datasource/sql"
+)
+
+const (
+	selectPendingQuery = `SELECT id, aggregate_id, topic, message_key, payload, headers, attempts FROM gofr_outbox o
+WHERE o.published_at IS NULL AND o.attempts < $1 AND NOT EXISTS (SELECT 1 FROM gofr_outbox f
+WHERE f.aggregate_id = o.aggregate_id AND f.published_at IS NULL AND f.attempts > 0 AND f.id < o.id)
+ORDER BY o.id LIMIT $2 FOR UPDATE`
+	publishedQuery    = `UPDATE gofr_outbox SET published_at = $1 WHERE id = $2`
+	failedQuery       = `UPDATE gofr_outbox SET attempts = attempts + 1, last_error = $1 WHERE id = $2`
+	deadLetteredQuery = `UPDATE gofr_outbox SET attempts = attempts + 1, last_error = $1, published_at = $2 WHERE id = $3`
+)
+
+var pendingColumns = []string{"id", "aggregate_id", "topic", "message_key", "payload", "headers", "attempts"}
+
+func newRelayTest(t *testing.T, dialect string, config Config) (*Relay, sqlmock.Sqlmock, *container.Mocks,
+	*container.MockOptionsPubSubProvider) {
+	t.Helper()
+
+	c, mocks := container.NewMockContainer(t)
+
+	pubSub := container.NewMockOptionsPubSubProvider(gomock.NewController(t))
+	c.PubSub = pubSub
+
+	db, mock, _ := gofrSql.NewSQLMocksWithConfig(t, &gofrSql.DBConfig{Dialect: dialect})
+	c.SQL = db
+
+	return NewRelay(c, config), mock, mocks, pubSub
+}
+
+func pendingRows() *sqlmock.Rows {
+	return sqlmock.NewRows(pendingColumns).
+		AddRow(1, "order-1", "orders", "order-1", []byte("1"), `{"tenant":"gofr"}`, 0).
+		AddRow(2, "order-2", "orders", "order-2", []byte("2"), nil, 0).
+		AddRow(3, "order-1", "orders", "order-1", []byte("3"), nil, 0).
+		AddRow(4, "order-2", "orders", "order-2", []byte("4"), nil, 0)
+}
+
+func TestRelay_relay(t *testing.T) {
+	r, mock, mocks, pubSub := newRelayTest(t, "postgres", Config{BatchSize: 4})
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(selectPendingQuery).WithArgs(defaultMaxAttempts, 4).WillReturnRows(pendingRows())
+
+	gomock.InOrder(
+		pubSub.EXPECT().PublishWithOptions(gomock.Any(), "orders", []byte("1"),
+			pubsub.PublishOptions{Headers: map[string]string{"tenant": "gofr"}, Key: "order-1"}).Return(nil),
+		pubSub.EXPECT().PublishWithOptions(gomock.Any(), "orders", []byte("2"),
+			pubsub.PublishOptions{Key: "order-2"}).Return(errDB),
+		pubSub.EXPECT().PublishWithOptions(gomock.Any(), "orders", []byte("3"),
+			pubsub.PublishOptions{Key: "order-1"}).Return(nil),
+	)
+
+	mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), "app_outbox_published_count", "topic", "orders").Times(2)
+	mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), "app_outbox_publish_error_count", "topic", "orders")
+
+	mock.ExpectExec(publishedQuery).WithArgs(sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(failedQuery).WithArgs(errDB.Error(), 2).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(publishedQuery).WithArgs(sqlmock.AnyArg(), 3).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	more := r.relay(t.Context())
+
+	assert.False(t, more, "more events are read after an event of the batch failed to be published")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestRelay_relay_StuckAggregate tests that the event of the stuck aggregate, failing in its last attempt, is
+// parked or dead-lettered, while the events of the healthy aggregate are published. The later events of the stuck
+// aggregate are not read, being excluded by the query.
+func TestRelay_relay_StuckAggregate(t *testing.T) {
+	stuckRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows(pendingColumns).
+			AddRow(1, "order-1", "orders", "order-1", []byte("1"), `{"tenant":"gofr"}`, 2).
+			AddRow(2, "order-2", "orders", "order-2", []byte("2"), nil, 0).
+			AddRow(3, "order-2", "orders", "order-2", []byte("3"), nil, 0)
+	}
+
+	t.Run("parked", func(t *testing.T) {
+		r, mock, mocks, pubSub := newRelayTest(t, "postgres", Config{BatchSize: 4, MaxAttempts: 3})
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectPendingQuery).WithArgs(3, 4).WillReturnRows(stuckRows())
+
+		gomock.InOrder(
+			pubSub.EXPECT().PublishWithOptions(gomock.Any(), "orders", []byte("1"), gomock.Any()).Return(errDB),
+			pubSub.EXPECT().PublishWithOptions(gomock.Any(), "orders", []byte("2"), gomock.Any()).Return(nil),
+			pubSub.EXPECT().PublishWithOptions(gomock.Any(), "orders", []byte("3"), gomock.Any()).Return(nil),
+		)
+
+		mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), "app_outbox_publish_error_count", "topic", "orders")
+		mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), "app_outbox_parked_count", "topic", "orders")
+		mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), "app_outbox_published_count", "topic", "orders").Times(2)
+
+		mock.ExpectExec(failedQuery).WithArgs(errDB.Error(), 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(publishedQuery).WithArgs(sqlmock.AnyArg(), 2).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(publishedQuery).WithArgs(sqlmock.AnyArg(), 3).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		assert.False(t, r.relay(t.Context()))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("dead-lettered", func(t *testing.T) {
+		r, mock, mocks, pubSub := newRelayTest(t, "postgres", Config{BatchSize: 3, MaxAttempts: 3, DeadLetterTopic: "orders-dlq"})
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectPendingQuery).WithArgs(3, 3).WillReturnRows(stuckRows())
+
+		gomock.InOrder(
+			pubSub.EXPECT().PublishWithOptions(gomock.Any(), "orders", []byte("1"), gomock.Any()).Return(errDB),
+			pubSub.EXPECT().PublishWithOptions(gomock.Any(), "orders-dlq", []byte("1"), pubsub.PublishOptions{
+				Headers: map[string]string{
+					"tenant":                 "gofr",
+					DeadLetterErrorHeader:    errDB.Error(),
+					DeadLetterTopicHeader:    "orders",
+					DeadLetterAttemptsHeader: "3",
+				},
+				Key: "order-1",
+			}).Return(nil),
+			pubSub.EXPECT().PublishWithOptions(gomock.Any(), "orders", []byte("2"), gomock.Any()).Return(nil),
+			pubSub.EXPECT().PublishWithOptions(gomock.Any(), "orders", []byte("3"), gomock.Any()).Return(nil),
+		)
+
+		mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), "app_outbox_publish_error_count", "topic", "orders")
+		mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), "app_outbox_dead_letter_count",
+			"topic", "orders", "dead_letter_topic", "orders-dlq")
+		mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), "app_outbox_published_count", "topic", "orders").Times(2)
+
+		mock.ExpectExec(deadLetteredQuery).WithArgs(errDB.Error(), sqlmock.AnyArg(), 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(publishedQuery).WithArgs(sqlmock.AnyArg(), 2).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(publishedQuery).WithArgs(sqlmock.AnyArg(), 3).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		assert.True(t, r.relay(t.Context()), "more events are read after the failed event is dead-lettered")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRelay_relay_Errors(t *testing.T) {
+	t.Run("read error", func(t *testing.T) {
+		r, mock, _, _ := newRelayTest(t, "sqlite", Config{BatchSize: 4})
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT id, aggregate_id, topic, message_key, payload, headers, attempts FROM gofr_outbox o
+WHERE o.published_at IS NULL AND o.attempts < ? AND NOT EXISTS (SELECT 1 FROM gofr_outbox f
+WHERE f.aggregate_id = o.aggregate_id AND f.published_at IS NULL AND f.attempts > 0 AND f.id < o.id)
+ORDER BY o.id LIMIT ?`).WithArgs(defaultMaxAttempts, 4).WillReturnError(errDB)
+		mock.ExpectRollback()
+
+		assert.False(t, r.relay(t.Context()))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("mark published error", func(t *testing.T) {
+		r, mock, mocks, pubSub := newRelayTest(t, "postgres", Config{BatchSize: 4})
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectPendingQuery).WithArgs(defaultMaxAttempts, 4).WillReturnRows(pendingRows())
+		pubSub.EXPECT().PublishWithOptions(gomock.Any(), "orders", []byte("1"), gomock.Any()).Return(nil)
+		mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), "app_outbox_published_count", "topic", "orders")
+		mock.ExpectExec(publishedQuery).WithArgs(sqlmock.AnyArg(), 1).WillReturnError(errDB)
+		mock.ExpectRollback()
+
+		assert.False(t, r.relay(t.Context()))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("full batch published", func(t *testing.T) {
+		r, mock, mocks, pubSub := newRelayTest(t, "postgres", Config{BatchSize: 4})
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectPendingQuery).WithArgs(defaultMaxAttempts, 4).WillReturnRows(pendingRows())
+		pubSub.EXPECT().PublishWithOptions(gomock.Any(), "orders", gomock.Any(), gomock.Any()).Return(nil).Times(4)
+		mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), "app_outbox_published_count", "topic", "orders").Times(4)
+
+		for id := 1; id <= 4; id++ {
+			mock.ExpectExec(publishedQuery).WithArgs(sqlmock.AnyArg(), id).WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+
+		mock.ExpectCommit()
+
+		assert.True(t, r.relay(t.Context()), "more events are not read after a full batch")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRelay_cleanup(t *testing.T) {
+	r, mock, _, _ := newRelayTest(t, "mysql", Config{})
+
+	mock.ExpectExec(`DELETE FROM gofr_outbox WHERE published_at IS NOT NULL AND published_at < ?`).
+		WithArgs(sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 3))
+
+	r.cleanup(t.Context())
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRelay_Run(t *testing.T) {
+	r, mock, _, _ := newRelayTest(t, "mysql", Config{PollInterval: time.Hour})
+
+	ctx, cancel := context.WithCancel(t.Context())
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id, aggregate_id, topic, message_key, payload, headers, attempts FROM gofr_outbox o
+WHERE o.published_at IS NULL AND o.attempts < ? AND NOT EXISTS (SELECT 1 FROM gofr_outbox f
+WHERE f.aggregate_id = o.aggregate_id AND f.published_at IS NULL AND f.attempts > 0 AND f.id < o.id)
+ORDER BY o.id LIMIT ? FOR UPDATE`).WithArgs(defaultMaxAttempts, defaultBatchSize).
+		WillReturnRows(sqlmock.NewRows(pendingColumns))
+	mock.ExpectCommit().WillReturnError(nil)
+
+	done := make(chan struct{})
+
+	go func() {
+		r.Run(ctx)
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool { return mock.ExpectationsWereMet() == nil }, time.Second, time.Millisecond)
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("relay did not stop after the context is done")
+	}
+}
+
+func TestNewRelay_Defaults(t *testing.T) {
+	r := NewRelay(&container.Container{}, Config{})
+
+	assert.Equal(t, Config{
+		PollInterval:    defaultPollInterval,
+		BatchSize:       defaultBatchSize,
+		Retention:       defaultRetention,
+		CleanupInterval: defaultCleanupInterval,
+		MaxAttempts:     defaultMaxAttempts,
+	}, r.config)
+}
@@ -1,6 +1,7 @@
 package gofr
 
 import (
+	"net/http"
 	"strconv"
 	"time"
 )
@@ -31,22 +32,37 @@ func (a *App) PATCH(pattern string, handler Handler) {
 }
 
 func (a *App) add(method, pattern string, h Handler) {
+	a.addHTTPRoute(method, pattern, handler{
+		function:       h,
+		container:      a.container,
+		requestTimeout: a.requestTimeout(),
+	})
+}
+
+// addHTTPRoute registers the handler on the HTTP server of the app.
+func (a *App) addHTTPRoute(method, pattern string, h http.Handler) {
+	a.enableHTTPServer()
+
+	a.httpServer.router.Add(method, pattern, h)
+}
+
+// enableHTTPServer starts the HTTP server with the app, as a route is registered on it.
+func (a *App) enableHTTPServer() {
 	if !a.httpRegistered && !isPortAvailable(a.httpServer.port) {
 		a.container.Logger.Fatalf("http port %d is blocked or unreachable", a.httpServer.port)
 	}
 
 	a.httpRegistered = true
+}
 
+// requestTimeout returns the REQUEST_TIMEOUT of the app, or zero if it is not set or invalid.
+func (a *App) requestTimeout() time.Duration {
 	reqTimeout, err := strconv.Atoi(a.Config.Get("REQUEST_TIMEOUT"))
-	if (err != nil && a.Config.Get("REQUEST_TIMEOUT") != "") || reqTimeout < 0 {
-		reqTimeout = 0
+	if err != nil || reqTimeout < 0 {
+		return 0
 	}
 
-	a.httpServer.router.Add(method, pattern, handler{
-		function:       h,
-		container:      a.container,
-		requestTimeout: time.Duration(reqTimeout) * time.Second,
-	})
+	return time.Duration(reqTimeout) * time.Second
 }
 
 // AddRESTHandlers creates and registers CRUD routes for the given struct, the struct should always be passed by reference.
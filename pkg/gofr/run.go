@@ -90,6 +90,7 @@ func (a *App) startAllServers(ctx context.Context) {
 	a.startHTTPServer(&wg)
 	a.startGRPCServer(&wg)
 	a.startSubscriptionManager(ctx, &wg)
+	a.startOutboxRelay(ctx, &wg)
 
 	wg.Wait()
 }
@@ -126,6 +127,8 @@ func (a *App) startHTTPServer(wg *sync.WaitGroup) {
 // startGRPCServer starts the gRPC server if registered.
 func (a *App) startGRPCServer(wg *sync.WaitGroup) {
 	if a.grpcRegistered {
+		a.grpcServer.health.start(a.grpcServer.server, a.container, a.grpcServer.healthCheckInterval(a.container))
+
 		wg.Add(1)
 
 		go func(s *grpcServer) {
@@ -149,3 +152,18 @@ func (a *App) startSubscriptionManager(ctx context.Context, wg *sync.WaitGroup)
 		}
 	}()
 }
+
+// startOutboxRelay starts the outbox relay if registered.
+func (a *App) startOutboxRelay(ctx context.Context, wg *sync.WaitGroup) {
+	if a.outboxRelay == nil {
+		return
+	}
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		a.outboxRelay.Run(ctx)
+	}()
+}
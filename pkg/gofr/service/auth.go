@@ -82,3 +82,39 @@ func (a *authProvider) DeleteWithHeaders(ctx context.Context, path string, body
 
 	return a.HTTP.DeleteWithHeaders(ctx, path, body, headers)
 }
+
+func (a *authProvider) PostStream(ctx context.Context, path string, queryParams map[string]any,
+	body RequestBody, headers map[string]string) (*http.Response, error) {
+	headers, err := a.auth(ctx, headers)
+	if err != nil {
+		closeBody(body.Reader)
+
+		return nil, err
+	}
+
+	return sendStream(ctx, a.HTTP, http.MethodPost, path, queryParams, body, headers)
+}
+
+func (a *authProvider) PutStream(ctx context.Context, path string, queryParams map[string]any,
+	body RequestBody, headers map[string]string) (*http.Response, error) {
+	headers, err := a.auth(ctx, headers)
+	if err != nil {
+		closeBody(body.Reader)
+
+		return nil, err
+	}
+
+	return sendStream(ctx, a.HTTP, http.MethodPut, path, queryParams, body, headers)
+}
+
+func (a *authProvider) PatchStream(ctx context.Context, path string, queryParams map[string]any,
+	body RequestBody, headers map[string]string) (*http.Response, error) {
+	headers, err := a.auth(ctx, headers)
+	if err != nil {
+		closeBody(body.Reader)
+
+		return nil, err
+	}
+
+	return sendStream(ctx, a.HTTP, http.MethodPatch, path, queryParams, body, headers)
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// ErrBulkheadFull is returned when the maximum number of concurrent requests to a service is reached
+// and no slot became available within the queue timeout.
+var ErrBulkheadFull = errors.New("maximum concurrent requests to service reached")
+
+// BulkheadConfig limits the number of requests that can be in flight to a service at the same time,
+// so that a slow downstream cannot hold on to all the goroutines of the application.
+type BulkheadConfig struct {
+	// MaxConcurrentRequests is the maximum number of requests allowed to be in flight at once.
+	MaxConcurrentRequests int
+	// QueueTimeout is the maximum time a request waits for a free slot before failing with ErrBulkheadFull.
+	// A zero value rejects requests immediately when all the slots are in use.
+	QueueTimeout time.Duration
+}
+
+func (b *BulkheadConfig) AddOption(h HTTP) HTTP {
+	return b.addInstrumentedOption(h, "", nil)
+}
+
+func (b *BulkheadConfig) addInstrumentedOption(h HTTP, serviceAddress string, metrics Metrics) HTTP {
+	// a bulkhead without any slot would reject every request, hence it is not applied.
+	if b.MaxConcurrentRequests <= 0 {
+		return h
+	}
+
+	return &bulkhead{
+		slots:          make(chan struct{}, b.MaxConcurrentRequests),
+		queueTimeout:   b.QueueTimeout,
+		serviceAddress: serviceAddress,
+		metrics:        counterMetrics(metrics),
+		HTTP:           h,
+	}
+}
+
+type bulkhead struct {
+	slots          chan struct{}
+	queueTimeout   time.Duration
+	serviceAddress string
+	metrics        CounterMetrics
+
+	HTTP
+}
+
+// acquire reserves a slot for a request, waiting at most the queue timeout for one to be released.
+func (b *bulkhead) acquire(ctx context.Context) error {
+	select {
+	case b.slots <- struct{}{}:
+		return nil
+	default:
+	}
+
+	if b.queueTimeout <= 0 {
+		return ErrBulkheadFull
+	}
+
+	timer := time.NewTimer(b.queueTimeout)
+	defer timer.Stop()
+
+	select {
+	case b.slots <- struct{}{}:
+		return nil
+	case <-timer.C:
+		return ErrBulkheadFull
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *bulkhead) release() {
+	<-b.slots
+}
+
+func (b *bulkhead) doRequest(ctx context.Context, method string, reqFunc func() (*http.Response, error)) (*http.Response, error) {
+	if err := b.acquire(ctx); err != nil {
+		if errors.Is(err, ErrBulkheadFull) && b.metrics != nil {
+			b.metrics.IncrementCounter(ctx, "app_http_service_rejected_count", "path", b.serviceAddress,
+				"method", method, "reason", "bulkhead")
+		}
+
+		return nil, err
+	}
+
+	defer b.release()
+
+	if b.metrics != nil {
+		b.metrics.DeltaUpDownCounter(ctx, "app_http_service_in_flight", 1, "path", b.serviceAddress)
+		defer b.metrics.DeltaUpDownCounter(ctx, "app_http_service_in_flight", -1, "path", b.serviceAddress)
+	}
+
+	return reqFunc()
+}
+
+func (b *bulkhead) Get(ctx context.Context, path string, queryParams map[string]any) (*http.Response, error) {
+	return b.doRequest(ctx, http.MethodGet, func() (*http.Response, error) {
+		return b.HTTP.Get(ctx, path, queryParams)
+	})
+}
+
+func (b *bulkhead) GetWithHeaders(ctx context.Context, path string, queryParams map[string]any,
+	headers map[string]string) (*http.Response, error) {
+	return b.doRequest(ctx, http.MethodGet, func() (*http.Response, error) {
+		return b.HTTP.GetWithHeaders(ctx, path, queryParams, headers)
+	})
+}
+
+func (b *bulkhead) Post(ctx context.Context, path string, queryParams map[string]any,
+	body []byte) (*http.Response, error) {
+	return b.doRequest(ctx, http.MethodPost, func() (*http.Response, error) {
+		return b.HTTP.Post(ctx, path, queryParams, body)
+	})
+}
+
+func (b *bulkhead) PostWithHeaders(ctx context.Context, path string, queryParams map[string]any, body []byte,
+	headers map[string]string) (*http.Response, error) {
+	return b.doRequest(ctx, http.MethodPost, func() (*http.Response, error) {
+		return b.HTTP.PostWithHeaders(ctx, path, queryParams, body, headers)
+	})
+}
+
+func (b *bulkhead) Put(ctx context.Context, path string, queryParams map[string]any, body []byte) (
+	*http.Response, error) {
+	return b.doRequest(ctx, http.MethodPut, func() (*http.Response, error) {
+		return b.HTTP.Put(ctx, path, queryParams, body)
+	})
+}
+
+func (b *bulkhead) PutWithHeaders(ctx context.Context, path string, queryParams map[string]any, body []byte,
+	headers map[string]string) (*http.Response, error) {
+	return b.doRequest(ctx, http.MethodPut, func() (*http.Response, error) {
+		return b.HTTP.PutWithHeaders(ctx, path, queryParams, body, headers)
+	})
+}
+
+func (b *bulkhead) Patch(ctx context.Context, path string, queryParams map[string]any, body []byte) (
+	*http.Response, error) {
+	return b.doRequest(ctx, http.MethodPatch, func() (*http.Response, error) {
+		return b.HTTP.Patch(ctx, path, queryParams, body)
+	})
+}
+
+func (b *bulkhead) PatchWithHeaders(ctx context.Context, path string, queryParams map[string]any, body []byte,
+	headers map[string]string) (*http.Response, error) {
+	return b.doRequest(ctx, http.MethodPatch, func() (*http.Response, error) {
+		return b.HTTP.PatchWithHeaders(ctx, path, queryParams, body, headers)
+	})
+}
+
+func (b *bulkhead) Delete(ctx context.Context, path string, body []byte) (*http.Response, error) {
+	return b.doRequest(ctx, http.MethodDelete, func() (*http.Response, error) {
+		return b.HTTP.Delete(ctx, path, body)
+	})
+}
+
+func (b *bulkhead) DeleteWithHeaders(ctx context.Context, path string, body []byte, headers map[string]string) (
+	*http.Response, error) {
+	return b.doRequest(ctx, http.MethodDelete, func() (*http.Response, error) {
+		return b.HTTP.DeleteWithHeaders(ctx, path, body, headers)
+	})
+}
+
+func (b *bulkhead) PostStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody,
+	headers map[string]string) (*http.Response, error) {
+	resp, err := b.doRequest(ctx, http.MethodPost, func() (*http.Response, error) {
+		return sendStream(ctx, b.HTTP, http.MethodPost, path, queryParams, body, headers)
+	})
+	if err != nil && resp == nil {
+		closeBody(body.Reader)
+	}
+
+	return resp, err
+}
+
+func (b *bulkhead) PutStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody,
+	headers map[string]string) (*http.Response, error) {
+	resp, err := b.doRequest(ctx, http.MethodPut, func() (*http.Response, error) {
+		return sendStream(ctx, b.HTTP, http.MethodPut, path, queryParams, body, headers)
+	})
+	if err != nil && resp == nil {
+		closeBody(body.Reader)
+	}
+
+	return resp, err
+}
+
+func (b *bulkhead) PatchStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody,
+	headers map[string]string) (*http.Response, error) {
+	resp, err := b.doRequest(ctx, http.MethodPatch, func() (*http.Response, error) {
+		return sendStream(ctx, b.HTTP, http.MethodPatch, path, queryParams, body, headers)
+	})
+	if err != nil && resp == nil {
+		closeBody(body.Reader)
+	}
+
+	return resp, err
+}
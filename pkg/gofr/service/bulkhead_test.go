@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// blockingHTTP blocks every GET request until release is closed.
+type blockingHTTP struct {
+	started chan struct{}
+	release chan struct{}
+
+	mockHTTP
+}
+
+func (b *blockingHTTP) Get(_ context.Context, _ string, _ map[string]any) (*http.Response, error) {
+	b.started <- struct{}{}
+	<-b.release
+
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
+
+func TestBulkhead_RejectsWhenFull(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	metrics := NewMockCounterMetrics(ctrl)
+
+	downstream := &blockingHTTP{started: make(chan struct{}, 1), release: make(chan struct{})}
+	config := &BulkheadConfig{MaxConcurrentRequests: 1, QueueTimeout: 10 * time.Millisecond}
+	svc := config.addInstrumentedOption(downstream, "http://test.com", metrics)
+
+	metrics.EXPECT().DeltaUpDownCounter(gomock.Any(), "app_http_service_in_flight", float64(1), "path", "http://test.com")
+	metrics.EXPECT().DeltaUpDownCounter(gomock.Any(), "app_http_service_in_flight", float64(-1), "path", "http://test.com")
+	metrics.EXPECT().IncrementCounter(gomock.Any(), "app_http_service_rejected_count", "path", "http://test.com",
+		"method", http.MethodGet, "reason", "bulkhead")
+
+	done := make(chan error)
+
+	go func() {
+		resp, err := svc.Get(t.Context(), "test", nil)
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		done <- err
+	}()
+
+	<-downstream.started
+
+	resp, err := svc.Get(t.Context(), "test", nil)
+
+	require.ErrorIs(t, err, ErrBulkheadFull)
+	assert.Nil(t, resp)
+
+	close(downstream.release)
+
+	require.NoError(t, <-done)
+}
+
+func TestBulkhead_MetricsWithoutCounters(t *testing.T) {
+	// the strict mock fails the test when the bulkhead records a counter on the Metrics which do not record them.
+	metrics := NewMockMetrics(gomock.NewController(t))
+
+	downstream := &blockingHTTP{started: make(chan struct{}, 1), release: make(chan struct{})}
+	config := &BulkheadConfig{MaxConcurrentRequests: 1, QueueTimeout: 10 * time.Millisecond}
+	svc := config.addInstrumentedOption(downstream, "http://test.com", metrics)
+
+	done := make(chan error)
+
+	go func() {
+		resp, err := svc.Get(t.Context(), "test", nil)
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		done <- err
+	}()
+
+	<-downstream.started
+
+	resp, err := svc.Get(t.Context(), "test", nil)
+
+	require.ErrorIs(t, err, ErrBulkheadFull)
+	assert.Nil(t, resp)
+
+	close(downstream.release)
+
+	require.NoError(t, <-done)
+}
+
+func TestBulkhead_WaitsForFreeSlot(t *testing.T) {
+	downstream := &blockingHTTP{started: make(chan struct{}, 2), release: make(chan struct{})}
+	config := &BulkheadConfig{MaxConcurrentRequests: 1, QueueTimeout: time.Second}
+	svc := config.AddOption(downstream)
+
+	done := make(chan error, 2)
+
+	for range 2 {
+		go func() {
+			resp, err := svc.Get(t.Context(), "test", nil)
+			if resp != nil {
+				resp.Body.Close()
+			}
+
+			done <- err
+		}()
+	}
+
+	<-downstream.started
+
+	close(downstream.release)
+
+	require.NoError(t, <-done)
+	require.NoError(t, <-done)
+}
+
+func TestBulkhead_ContextCancelledWhileQueued(t *testing.T) {
+	downstream := &blockingHTTP{started: make(chan struct{}, 1), release: make(chan struct{})}
+	config := &BulkheadConfig{MaxConcurrentRequests: 1, QueueTimeout: time.Minute}
+	svc := config.AddOption(downstream)
+
+	go func() {
+		resp, _ := svc.Get(t.Context(), "test", nil)
+		if resp != nil {
+			resp.Body.Close()
+		}
+	}()
+
+	<-downstream.started
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	resp, err := svc.Get(ctx, "test", nil)
+
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, resp)
+
+	close(downstream.release)
+}
+
+func TestBulkhead_NotAppliedWithoutSlots(t *testing.T) {
+	downstream := &mockHTTP{}
+	config := &BulkheadConfig{}
+
+	assert.Equal(t, downstream, config.AddOption(downstream))
+}
+
+func TestBulkhead_AllMethods(t *testing.T) {
+	config := &BulkheadConfig{MaxConcurrentRequests: 1}
+	svc := config.AddOption(&mockHTTP{}).(StreamingHTTP)
+	ctx := t.Context()
+
+	calls := []func() (*http.Response, error){
+		func() (*http.Response, error) { return svc.Get(ctx, "test", nil) },
+		func() (*http.Response, error) { return svc.GetWithHeaders(ctx, "test", nil, nil) },
+		func() (*http.Response, error) { return svc.Post(ctx, "test", nil, nil) },
+		func() (*http.Response, error) { return svc.PostWithHeaders(ctx, "test", nil, nil, nil) },
+		func() (*http.Response, error) { return svc.Put(ctx, "test", nil, nil) },
+		func() (*http.Response, error) { return svc.PutWithHeaders(ctx, "test", nil, nil, nil) },
+		func() (*http.Response, error) { return svc.Patch(ctx, "test", nil, nil) },
+		func() (*http.Response, error) { return svc.PatchWithHeaders(ctx, "test", nil, nil, nil) },
+		func() (*http.Response, error) { return svc.Delete(ctx, "test", nil) },
+		func() (*http.Response, error) { return svc.DeleteWithHeaders(ctx, "test", nil, nil) },
+		func() (*http.Response, error) { return svc.PostStream(ctx, "test", nil, RequestBody{}, nil) },
+		func() (*http.Response, error) { return svc.PutStream(ctx, "test", nil, RequestBody{}, nil) },
+		func() (*http.Response, error) { return svc.PatchStream(ctx, "test", nil, RequestBody{}, nil) },
+	}
+
+	for i, call := range calls {
+		resp, err := call()
+
+		require.NoError(t, err, "TEST[%d], Failed.\n", i)
+		resp.Body.Close()
+	}
+}
@@ -0,0 +1,421 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	cacheKeyPrefix = "gofr_http_cache:"
+
+	// validatorRetention is the time for which a stale response having an ETag or Last-Modified header is
+	// retained, so that it can be revalidated with a conditional request instead of being fetched again.
+	validatorRetention = time.Hour
+
+	// fetchTimeout bounds the upstream call shared by the coalesced requests, as it is not cancelled with their
+	// contexts.
+	fetchTimeout = time.Minute
+)
+
+// CacheConfig caches the responses of GET requests to a service. Cache-Control and Expires headers of the
+// upstream responses are respected, stale responses having an ETag or Last-Modified header are revalidated
+// with a conditional request, and concurrent identical requests are coalesced into a single upstream call.
+type CacheConfig struct {
+	// Store is where the responses are cached. Responses are cached in memory if it is not set.
+	Store CacheStore
+	// UseRedis caches the responses in the Redis of the application when registered through AddHTTPService.
+	UseRedis bool
+	// MaxEntries is the maximum number of responses cached in memory. Defaults to 1000.
+	MaxEntries int
+	// DefaultTTL is the freshness lifetime of responses that do not specify one through Cache-Control or Expires.
+	// Such responses are not cached if it is zero.
+	DefaultTTL time.Duration
+	// StaleWhileRevalidate is the time after expiry during which a stale response is served while it is
+	// revalidated in background, unless the upstream response specifies its own stale-while-revalidate.
+	StaleWhileRevalidate time.Duration
+	// StaleIfError is the time after expiry during which a stale response is served if the upstream call fails,
+	// unless the upstream response specifies its own stale-if-error.
+	StaleIfError time.Duration
+}
+
+// WithRedis returns a copy of the config caching the responses in the given Redis client. If the client is nil,
+// the responses are cached in memory.
+func (c *CacheConfig) WithRedis(client redis.Cmdable) *CacheConfig {
+	config := *c
+	config.UseRedis = false
+
+	if client != nil && !reflect.ValueOf(client).IsNil() {
+		config.Store = NewRedisCacheStore(client)
+	}
+
+	return &config
+}
+
+func (c *CacheConfig) AddOption(h HTTP) HTTP {
+	return c.addInstrumentedOption(h, "", nil)
+}
+
+func (c *CacheConfig) addInstrumentedOption(h HTTP, serviceAddress string, metrics Metrics) HTTP {
+	store := c.Store
+	if store == nil {
+		store = NewInMemoryCacheStore(c.MaxEntries)
+	}
+
+	return &responseCache{
+		store:                store,
+		defaultTTL:           c.DefaultTTL,
+		staleWhileRevalidate: c.StaleWhileRevalidate,
+		staleIfError:         c.StaleIfError,
+		serviceAddress:       serviceAddress,
+		metrics:              counterMetrics(metrics),
+		HTTP:                 h,
+	}
+}
+
+type responseCache struct {
+	store                CacheStore
+	defaultTTL           time.Duration
+	staleWhileRevalidate time.Duration
+	staleIfError         time.Duration
+	group                singleflight.Group
+	serviceAddress       string
+	metrics              CounterMetrics
+
+	HTTP
+}
+
+// cachedResponse is the representation of a response in the CacheStore.
+type cachedResponse struct {
+	StatusCode           int           `json:"statusCode"`
+	Header               http.Header   `json:"header"`
+	Body                 []byte        `json:"body"`
+	FreshUntil           time.Time     `json:"freshUntil"`
+	StaleWhileRevalidate time.Duration `json:"staleWhileRevalidate"`
+	StaleIfError         time.Duration `json:"staleIfError"`
+}
+
+func (cr *cachedResponse) response() *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", cr.StatusCode, http.StatusText(cr.StatusCode)),
+		StatusCode:    cr.StatusCode,
+		Header:        cr.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(cr.Body)),
+		ContentLength: int64(len(cr.Body)),
+	}
+}
+
+func (cr *cachedResponse) hasValidator() bool {
+	return cr.Header.Get("ETag") != "" || cr.Header.Get("Last-Modified") != ""
+}
+
+func (c *responseCache) Get(ctx context.Context, path string, queryParams map[string]any) (*http.Response, error) {
+	return c.GetWithHeaders(ctx, path, queryParams, nil)
+}
+
+func (c *responseCache) GetWithHeaders(ctx context.Context, path string, queryParams map[string]any,
+	headers map[string]string) (*http.Response, error) {
+	key := c.cacheKey(path, queryParams, headers)
+	entry := c.load(ctx, key)
+	now := time.Now()
+
+	if entry != nil {
+		switch {
+		case now.Before(entry.FreshUntil):
+			c.recordCacheResult(ctx, "hit")
+
+			return entry.response(), nil
+		case now.Before(entry.FreshUntil.Add(entry.StaleWhileRevalidate)):
+			c.recordCacheResult(ctx, "stale")
+
+			go c.revalidate(context.WithoutCancel(ctx), key, path, queryParams, headers, entry)
+
+			return entry.response(), nil
+		}
+	}
+
+	c.recordCacheResult(ctx, "miss")
+
+	fetched, err := c.fetch(ctx, key, path, queryParams, headers, entry)
+
+	if entry != nil && (err != nil || fetched.StatusCode >= http.StatusInternalServerError) &&
+		time.Now().Before(entry.FreshUntil.Add(entry.StaleIfError)) {
+		c.recordCacheResult(ctx, "stale")
+
+		return entry.response(), nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fetched.response(), nil
+}
+
+func (c *responseCache) PostStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody,
+	headers map[string]string) (*http.Response, error) {
+	return sendStream(ctx, c.HTTP, http.MethodPost, path, queryParams, body, headers)
+}
+
+func (c *responseCache) PutStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody,
+	headers map[string]string) (*http.Response, error) {
+	return sendStream(ctx, c.HTTP, http.MethodPut, path, queryParams, body, headers)
+}
+
+func (c *responseCache) PatchStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody,
+	headers map[string]string) (*http.Response, error) {
+	return sendStream(ctx, c.HTTP, http.MethodPatch, path, queryParams, body, headers)
+}
+
+// fetch calls the upstream service, coalescing concurrent calls for the same key into one. The shared call is not
+// cancelled with the context of the request starting it, so that the other requests waiting for it do not fail with
+// that request; each request stops waiting once its own context is done.
+func (c *responseCache) fetch(ctx context.Context, key, path string, queryParams map[string]any,
+	headers map[string]string, entry *cachedResponse) (*cachedResponse, error) {
+	results := c.group.DoChan(key, func() (any, error) {
+		// the shared call keeps the timeout set by WithRequestTimeout and the timeout of the client, and is bounded
+		// by fetchTimeout when neither is set.
+		fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), fetchTimeout)
+		defer cancel()
+
+		return c.fetchAndStore(fetchCtx, key, path, queryParams, headers, entry)
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-results:
+		if result.Err != nil {
+			return nil, result.Err
+		}
+
+		return result.Val.(*cachedResponse), nil
+	}
+}
+
+func (c *responseCache) revalidate(ctx context.Context, key, path string, queryParams map[string]any,
+	headers map[string]string, entry *cachedResponse) {
+	_, _ = c.fetch(ctx, key, path, queryParams, headers, entry)
+}
+
+func (c *responseCache) fetchAndStore(ctx context.Context, key, path string, queryParams map[string]any,
+	headers map[string]string, entry *cachedResponse) (*cachedResponse, error) {
+	resp, err := c.HTTP.GetWithHeaders(ctx, path, queryParams, conditionalHeaders(headers, entry))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && entry != nil {
+		refreshed := &cachedResponse{
+			StatusCode: entry.StatusCode,
+			Header:     entry.Header.Clone(),
+			Body:       entry.Body,
+		}
+
+		for k, v := range resp.Header {
+			refreshed.Header[k] = v
+		}
+
+		c.save(ctx, key, refreshed)
+		c.recordCacheResult(ctx, "revalidated")
+
+		return refreshed, nil
+	}
+
+	fetched := &cachedResponse{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       body,
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		c.save(ctx, key, fetched)
+	}
+
+	return fetched, nil
+}
+
+// save computes the freshness of the response from its headers and saves it in the CacheStore, if cacheable.
+// The cache is best effort, so failures to save the response are ignored.
+func (c *responseCache) save(ctx context.Context, key string, cr *cachedResponse) {
+	directives := parseCacheControl(cr.Header.Get("Cache-Control"))
+
+	if _, ok := directives["no-store"]; ok {
+		return
+	}
+
+	if _, ok := directives["private"]; ok {
+		return
+	}
+
+	lifetime, ok := c.freshnessLifetime(cr.Header, directives)
+	if !ok && !cr.hasValidator() {
+		return
+	}
+
+	cr.FreshUntil = time.Now().Add(lifetime)
+	cr.StaleWhileRevalidate = directiveDuration(directives, "stale-while-revalidate", c.staleWhileRevalidate)
+	cr.StaleIfError = directiveDuration(directives, "stale-if-error", c.staleIfError)
+
+	retention := lifetime + max(cr.StaleWhileRevalidate, cr.StaleIfError)
+	if cr.hasValidator() {
+		retention = max(retention, lifetime+validatorRetention)
+	}
+
+	if retention <= 0 {
+		return
+	}
+
+	value, err := json.Marshal(cr)
+	if err != nil {
+		return
+	}
+
+	_ = c.store.Set(ctx, key, value, retention)
+}
+
+func (c *responseCache) load(ctx context.Context, key string) *cachedResponse {
+	value, ok, err := c.store.Get(ctx, key)
+	if err != nil || !ok {
+		return nil
+	}
+
+	var cr cachedResponse
+
+	if err := json.Unmarshal(value, &cr); err != nil {
+		return nil
+	}
+
+	return &cr
+}
+
+// freshnessLifetime returns the time for which a response can be served from the cache without revalidation
+// and whether it was determined by the upstream response or the configured default.
+func (c *responseCache) freshnessLifetime(header http.Header, directives map[string]string) (time.Duration, bool) {
+	if _, ok := directives["no-cache"]; ok {
+		return 0, false
+	}
+
+	for _, directive := range []string{"s-maxage", "max-age"} {
+		if value, ok := directives[directive]; ok {
+			seconds, err := strconv.Atoi(value)
+			if err != nil || seconds < 0 {
+				return 0, false
+			}
+
+			return time.Duration(seconds) * time.Second, true
+		}
+	}
+
+	if expires := header.Get("Expires"); expires != "" {
+		expiresAt, err := http.ParseTime(expires)
+		if err != nil {
+			return 0, false
+		}
+
+		return max(time.Until(expiresAt), 0), true
+	}
+
+	return c.defaultTTL, c.defaultTTL > 0
+}
+
+func (c *responseCache) cacheKey(path string, queryParams map[string]any, headers map[string]string) string {
+	query := url.Values{}
+	addQueryParameters(query, queryParams)
+
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s/%s?%s", c.serviceAddress, path, query.Encode())
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	for _, name := range names {
+		fmt.Fprintf(hash, "\n%s:%s", strings.ToLower(name), headers[name])
+	}
+
+	return cacheKeyPrefix + hex.EncodeToString(hash.Sum(nil))
+}
+
+func (c *responseCache) recordCacheResult(ctx context.Context, result string) {
+	if c.metrics != nil {
+		c.metrics.IncrementCounter(ctx, "app_http_service_cache_count", "path", c.serviceAddress, "result", result)
+	}
+}
+
+// conditionalHeaders adds the validators of the cached response to the request headers, so that the
+// upstream service can respond with 304 Not Modified if the response has not changed.
+func conditionalHeaders(headers map[string]string, entry *cachedResponse) map[string]string {
+	if entry == nil || !entry.hasValidator() {
+		return headers
+	}
+
+	conditional := make(map[string]string, len(headers)+2)
+
+	for k, v := range headers {
+		conditional[k] = v
+	}
+
+	if etag := entry.Header.Get("ETag"); etag != "" {
+		conditional["If-None-Match"] = etag
+	}
+
+	if lastModified := entry.Header.Get("Last-Modified"); lastModified != "" {
+		conditional["If-Modified-Since"] = lastModified
+	}
+
+	return conditional
+}
+
+func parseCacheControl(header string) map[string]string {
+	directives := make(map[string]string)
+
+	for _, part := range strings.Split(header, ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		if name == "" {
+			continue
+		}
+
+		directives[strings.ToLower(name)] = strings.Trim(value, `"`)
+	}
+
+	return directives
+}
+
+func directiveDuration(directives map[string]string, name string, fallback time.Duration) time.Duration {
+	value, ok := directives[name]
+	if !ok {
+		return fallback
+	}
+
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 0 {
+		return fallback
+	}
+
+	return time.Duration(seconds) * time.Second
+}
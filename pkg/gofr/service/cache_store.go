@@ -0,0 +1,120 @@
+package service
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const defaultMaxCacheEntries = 1000
+
+// CacheStore stores the cached responses of a service.
+type CacheStore interface {
+	// Get returns the value stored for the key and whether it was found.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set stores the value for the key, to be evicted after the ttl.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+}
+
+type inMemoryCacheStore struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	// order holds the entries from the most to the least recently used.
+	order *list.List
+}
+
+type inMemoryCacheEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewInMemoryCacheStore returns a CacheStore holding at most maxEntries values in memory, evicting the least
+// recently used ones once full. maxEntries defaults to 1000 if not positive.
+func NewInMemoryCacheStore(maxEntries int) CacheStore {
+	if maxEntries <= 0 {
+		maxEntries = defaultMaxCacheEntries
+	}
+
+	return &inMemoryCacheStore{
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		order:      list.New(),
+	}
+}
+
+func (s *inMemoryCacheStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	element, ok := s.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+
+	entry := element.Value.(*inMemoryCacheEntry)
+
+	if time.Now().After(entry.expiresAt) {
+		s.remove(element)
+
+		return nil, false, nil
+	}
+
+	s.order.MoveToFront(element)
+
+	return entry.value, true, nil
+}
+
+func (s *inMemoryCacheStore) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if element, ok := s.entries[key]; ok {
+		s.remove(element)
+	}
+
+	for len(s.entries) >= s.maxEntries {
+		s.remove(s.order.Back())
+	}
+
+	s.entries[key] = s.order.PushFront(&inMemoryCacheEntry{key: key, value: value, expiresAt: time.Now().Add(ttl)})
+
+	return nil
+}
+
+func (s *inMemoryCacheStore) remove(element *list.Element) {
+	s.order.Remove(element)
+	delete(s.entries, element.Value.(*inMemoryCacheEntry).key)
+}
+
+type redisCacheStore struct {
+	client redis.Cmdable
+}
+
+// NewRedisCacheStore returns a CacheStore keeping the values in Redis, so that they are shared by all the
+// instances of the application.
+func NewRedisCacheStore(client redis.Cmdable) CacheStore {
+	return &redisCacheStore{client: client}
+}
+
+func (s *redisCacheStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := s.client.Get(ctx, key).Bytes()
+
+	switch {
+	case errors.Is(err, redis.Nil):
+		return nil, false, nil
+	case err != nil:
+		return nil, false, err
+	default:
+		return value, true, nil
+	}
+}
+
+func (s *redisCacheStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return s.client.Set(ctx, key, value, ttl).Err()
+}
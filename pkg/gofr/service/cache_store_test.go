@@ -0,0 +1,87 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInMemoryCacheStore(t *testing.T) {
+	store := NewInMemoryCacheStore(2)
+	ctx := t.Context()
+
+	require.NoError(t, store.Set(ctx, "a", []byte("1"), time.Minute))
+	require.NoError(t, store.Set(ctx, "b", []byte("2"), time.Minute))
+
+	// reading "a" makes "b" the least recently used entry.
+	value, ok, err := store.Get(ctx, "a")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("1"), value)
+
+	require.NoError(t, store.Set(ctx, "c", []byte("3"), time.Minute))
+
+	_, ok, _ = store.Get(ctx, "b")
+	assert.False(t, ok, "least recently used entry should be evicted")
+
+	_, ok, _ = store.Get(ctx, "c")
+	assert.True(t, ok)
+}
+
+func TestInMemoryCacheStore_Expiry(t *testing.T) {
+	store := NewInMemoryCacheStore(0)
+	ctx := t.Context()
+
+	require.NoError(t, store.Set(ctx, "a", []byte("1"), -time.Second))
+
+	_, ok, err := store.Get(ctx, "a")
+
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestRedisCacheStore(t *testing.T) {
+	s, err := miniredis.Run()
+	require.NoError(t, err)
+
+	defer s.Close()
+
+	client := redis.NewClient(&redis.Options{Addr: s.Addr()})
+	store := NewRedisCacheStore(client)
+	ctx := t.Context()
+
+	_, ok, err := store.Get(ctx, "a")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	require.NoError(t, store.Set(ctx, "a", []byte("1"), time.Minute))
+
+	value, ok, err := store.Get(ctx, "a")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("1"), value)
+
+	s.Close()
+
+	_, _, err = store.Get(ctx, "a")
+	require.Error(t, err)
+}
+
+func TestCacheConfig_WithRedis(t *testing.T) {
+	config := &CacheConfig{UseRedis: true, DefaultTTL: time.Minute}
+
+	var nilClient *redis.Client
+
+	inMemory := config.WithRedis(nilClient)
+	assert.Nil(t, inMemory.Store)
+	assert.False(t, inMemory.UseRedis)
+
+	withRedis := config.WithRedis(redis.NewClient(&redis.Options{}))
+	assert.IsType(t, &redisCacheStore{}, withRedis.Store)
+	assert.Equal(t, time.Minute, withRedis.DefaultTTL)
+	assert.True(t, config.UseRedis, "original config must not be modified")
+}
@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gofr.dev/pkg/gofr/logging"
+)
+
+func newCachedService(t *testing.T, config *CacheConfig, handler http.HandlerFunc) (HTTP, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	return NewHTTPService(server.URL, logging.NewMockLogger(logging.INFO), nil, config), &calls
+}
+
+func readBody(t *testing.T, resp *http.Response, err error) string {
+	t.Helper()
+
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	return string(body)
+}
+
+func TestResponseCache_FreshHit(t *testing.T) {
+	svc, calls := newCachedService(t, &CacheConfig{}, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Cache-Control", "max-age=60")
+		_, _ = w.Write([]byte("cached"))
+	})
+
+	for range 3 {
+		resp, err := svc.Get(t.Context(), "test", map[string]any{"key": "value"})
+
+		assert.Equal(t, "cached", readBody(t, resp, err))
+	}
+
+	assert.Equal(t, int32(1), calls.Load())
+
+	resp, err := svc.Get(t.Context(), "test", map[string]any{"key": "other"})
+
+	assert.Equal(t, "cached", readBody(t, resp, err))
+	assert.Equal(t, int32(2), calls.Load(), "requests with different query params must not share the cache")
+}
+
+func TestResponseCache_NotCacheable(t *testing.T) {
+	tests := []struct {
+		desc         string
+		config       *CacheConfig
+		cacheControl string
+		status       int
+	}{
+		{"no-store directive", &CacheConfig{DefaultTTL: time.Minute}, "no-store", http.StatusOK},
+		{"private directive", &CacheConfig{DefaultTTL: time.Minute}, "private, max-age=60", http.StatusOK},
+		{"no lifetime and no default TTL", &CacheConfig{}, "", http.StatusOK},
+		{"non 200 response", &CacheConfig{}, "max-age=60", http.StatusNotFound},
+	}
+
+	for i, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			svc, calls := newCachedService(t, tc.config, func(w http.ResponseWriter, _ *http.Request) {
+				if tc.cacheControl != "" {
+					w.Header().Set("Cache-Control", tc.cacheControl)
+				}
+
+				w.WriteHeader(tc.status)
+			})
+
+			for range 2 {
+				resp, err := svc.Get(t.Context(), "test", nil)
+
+				readBody(t, resp, err)
+			}
+
+			assert.Equal(t, int32(2), calls.Load(), "TEST[%d], Failed.\n%s", i, tc.desc)
+		})
+	}
+}
+
+func TestResponseCache_DefaultTTL(t *testing.T) {
+	svc, calls := newCachedService(t, &CacheConfig{DefaultTTL: time.Minute}, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("cached"))
+	})
+
+	for range 2 {
+		resp, err := svc.Get(t.Context(), "test", nil)
+
+		assert.Equal(t, "cached", readBody(t, resp, err))
+	}
+
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestResponseCache_RevalidatesWithETag(t *testing.T) {
+	svc, calls := newCachedService(t, &CacheConfig{}, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("ETag", `"v1"`)
+
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+
+			return
+		}
+
+		_, _ = w.Write([]byte("original"))
+	})
+
+	for range 2 {
+		resp, err := svc.Get(t.Context(), "test", nil)
+
+		assert.Equal(t, "original", readBody(t, resp, err))
+	}
+
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestResponseCache_StaleIfError(t *testing.T) {
+	var failing atomic.Bool
+
+	svc, calls := newCachedService(t, &CacheConfig{}, func(w http.ResponseWriter, _ *http.Request) {
+		if failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		w.Header().Set("Cache-Control", "max-age=0, stale-if-error=60")
+		_, _ = w.Write([]byte("stale"))
+	})
+
+	resp, err := svc.Get(t.Context(), "test", nil)
+
+	assert.Equal(t, "stale", readBody(t, resp, err))
+
+	failing.Store(true)
+
+	resp, err = svc.Get(t.Context(), "test", nil)
+
+	assert.Equal(t, "stale", readBody(t, resp, err))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestResponseCache_StaleWhileRevalidate(t *testing.T) {
+	var version atomic.Int32
+
+	svc, calls := newCachedService(t, &CacheConfig{StaleWhileRevalidate: time.Minute}, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Cache-Control", "max-age=0")
+
+		if version.Add(1) == 1 {
+			_, _ = w.Write([]byte("v1"))
+
+			return
+		}
+
+		_, _ = w.Write([]byte("v2"))
+	})
+
+	resp, err := svc.Get(t.Context(), "test", nil)
+	assert.Equal(t, "v1", readBody(t, resp, err))
+
+	resp, err = svc.Get(t.Context(), "test", nil)
+	assert.Equal(t, "v1", readBody(t, resp, err), "stale response is expected while revalidating")
+
+	assert.Eventually(t, func() bool { return calls.Load() == 2 }, time.Second, 10*time.Millisecond)
+}
+
+func TestResponseCache_CoalescesConcurrentRequests(t *testing.T) {
+	release := make(chan struct{})
+
+	svc, calls := newCachedService(t, &CacheConfig{}, func(w http.ResponseWriter, _ *http.Request) {
+		<-release
+
+		w.Header().Set("Cache-Control", "max-age=60")
+		_, _ = w.Write([]byte("shared"))
+	})
+
+	const requests = 5
+
+	var wg sync.WaitGroup
+
+	bodies := make(chan string, requests)
+
+	for range requests {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			resp, err := svc.Get(t.Context(), "test", nil)
+			bodies <- readBody(t, resp, err)
+		}()
+	}
+
+	assert.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, 10*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(bodies)
+
+	for body := range bodies {
+		assert.Equal(t, "shared", body)
+	}
+
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestResponseCache_CoalescedRequestOutlivesCancelledCaller(t *testing.T) {
+	release := make(chan struct{})
+
+	svc, calls := newCachedService(t, &CacheConfig{}, func(w http.ResponseWriter, _ *http.Request) {
+		<-release
+
+		w.Header().Set("Cache-Control", "max-age=60")
+		_, _ = w.Write([]byte("shared"))
+	})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	first := make(chan error)
+
+	go func() {
+		resp, err := svc.Get(ctx, "test", nil)
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		first <- err
+	}()
+
+	assert.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, 10*time.Millisecond)
+
+	second := make(chan string)
+
+	go func() {
+		resp, err := svc.Get(t.Context(), "test", nil)
+		second <- readBody(t, resp, err)
+	}()
+
+	// the request starting the shared call stops waiting once it is cancelled, while the call goes on for the other.
+	cancel()
+	require.ErrorIs(t, <-first, context.Canceled)
+
+	close(release)
+
+	assert.Equal(t, "shared", <-second)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestResponseCache_OnlyGETIsCached(t *testing.T) {
+	svc, calls := newCachedService(t, &CacheConfig{}, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Cache-Control", "max-age=60")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	for range 2 {
+		resp, err := svc.Post(t.Context(), "test", nil, nil)
+
+		readBody(t, resp, err)
+	}
+
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func Test_parseCacheControl(t *testing.T) {
+	directives := parseCacheControl(`public, Max-Age=60, stale-if-error="120",, no-cache`)
+
+	assert.Equal(t, map[string]string{"public": "", "max-age": "60", "stale-if-error": "120", "no-cache": ""}, directives)
+}
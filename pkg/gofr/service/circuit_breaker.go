@@ -171,39 +171,42 @@ func (*circuitBreaker) handleCircuitBreakerResult(result any, err error) (*http.
 
 func (cb *circuitBreaker) doRequest(ctx context.Context, method, path string, queryParams map[string]any,
 	body []byte, headers map[string]string) (*http.Response, error) {
-	if cb.isOpen() {
-		if !cb.tryCircuitRecovery() {
-			return nil, ErrCircuitOpen
-		}
-	}
-
-	var result any
-
-	var err error
-
 	switch method {
 	case http.MethodGet:
-		result, err = cb.executeWithCircuitBreaker(ctx, func(ctx context.Context) (*http.Response, error) {
+		return cb.execute(ctx, func(ctx context.Context) (*http.Response, error) {
 			return cb.HTTP.GetWithHeaders(ctx, path, queryParams, headers)
 		})
 	case http.MethodPost:
-		result, err = cb.executeWithCircuitBreaker(ctx, func(ctx context.Context) (*http.Response, error) {
+		return cb.execute(ctx, func(ctx context.Context) (*http.Response, error) {
 			return cb.HTTP.PostWithHeaders(ctx, path, queryParams, body, headers)
 		})
 	case http.MethodPatch:
-		result, err = cb.executeWithCircuitBreaker(ctx, func(ctx context.Context) (*http.Response, error) {
+		return cb.execute(ctx, func(ctx context.Context) (*http.Response, error) {
 			return cb.HTTP.PatchWithHeaders(ctx, path, queryParams, body, headers)
 		})
 	case http.MethodPut:
-		result, err = cb.executeWithCircuitBreaker(ctx, func(ctx context.Context) (*http.Response, error) {
+		return cb.execute(ctx, func(ctx context.Context) (*http.Response, error) {
 			return cb.HTTP.PutWithHeaders(ctx, path, queryParams, body, headers)
 		})
 	case http.MethodDelete:
-		result, err = cb.executeWithCircuitBreaker(ctx, func(ctx context.Context) (*http.Response, error) {
+		return cb.execute(ctx, func(ctx context.Context) (*http.Response, error) {
 			return cb.HTTP.DeleteWithHeaders(ctx, path, body, headers)
 		})
 	}
 
+	return cb.handleCircuitBreakerResult(nil, nil)
+}
+
+// execute sends the request unless the circuit is open and cannot be recovered.
+func (cb *circuitBreaker) execute(ctx context.Context, f func(ctx context.Context) (*http.Response, error)) (*http.Response, error) {
+	if cb.isOpen() {
+		if !cb.tryCircuitRecovery() {
+			return nil, ErrCircuitOpen
+		}
+	}
+
+	result, err := cb.executeWithCircuitBreaker(ctx, f)
+
 	resp, err := cb.handleCircuitBreakerResult(result, err)
 	if err != nil {
 		return nil, err
@@ -212,6 +215,23 @@ func (cb *circuitBreaker) doRequest(ctx context.Context, method, path string, qu
 	return resp, err
 }
 
+// doStreamRequest sends a request with a streamed body, which is closed if the circuit does not let it through.
+func (cb *circuitBreaker) doStreamRequest(ctx context.Context, body RequestBody,
+	f func(ctx context.Context) (*http.Response, error)) (*http.Response, error) {
+	sent := false
+
+	resp, err := cb.execute(ctx, func(ctx context.Context) (*http.Response, error) {
+		sent = true
+
+		return f(ctx)
+	})
+	if !sent {
+		closeBody(body.Reader)
+	}
+
+	return resp, err
+}
+
 func (cb *circuitBreaker) GetWithHeaders(ctx context.Context, path string, queryParams map[string]any,
 	headers map[string]string) (*http.Response, error) {
 	return cb.doRequest(ctx, http.MethodGet, path, queryParams, nil, headers)
@@ -268,3 +288,27 @@ func (cb *circuitBreaker) Delete(ctx context.Context, path string, body []byte)
 	*http.Response, error) {
 	return cb.doRequest(ctx, http.MethodDelete, path, nil, body, nil)
 }
+
+// PostStream is a wrapper for doStreamRequest with the POST method.
+func (cb *circuitBreaker) PostStream(ctx context.Context, path string, queryParams map[string]any,
+	body RequestBody, headers map[string]string) (*http.Response, error) {
+	return cb.doStreamRequest(ctx, body, func(ctx context.Context) (*http.Response, error) {
+		return sendStream(ctx, cb.HTTP, http.MethodPost, path, queryParams, body, headers)
+	})
+}
+
+// PutStream is a wrapper for doStreamRequest with the PUT method.
+func (cb *circuitBreaker) PutStream(ctx context.Context, path string, queryParams map[string]any,
+	body RequestBody, headers map[string]string) (*http.Response, error) {
+	return cb.doStreamRequest(ctx, body, func(ctx context.Context) (*http.Response, error) {
+		return sendStream(ctx, cb.HTTP, http.MethodPut, path, queryParams, body, headers)
+	})
+}
+
+// PatchStream is a wrapper for doStreamRequest with the PATCH method.
+func (cb *circuitBreaker) PatchStream(ctx context.Context, path string, queryParams map[string]any,
+	body RequestBody, headers map[string]string) (*http.Response, error) {
+	return cb.doStreamRequest(ctx, body, func(ctx context.Context) (*http.Response, error) {
+		return sendStream(ctx, cb.HTTP, http.MethodPatch, path, queryParams, body, headers)
+	})
+}
@@ -80,6 +80,27 @@ func (a *customHeader) DeleteWithHeaders(ctx context.Context, path string, body
 	return a.HTTP.DeleteWithHeaders(ctx, path, body, headers)
 }
 
+func (a *customHeader) PostStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody,
+	headers map[string]string) (*http.Response, error) {
+	headers = setCustomHeader(headers, a.Headers)
+
+	return sendStream(ctx, a.HTTP, http.MethodPost, path, queryParams, body, headers)
+}
+
+func (a *customHeader) PutStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody,
+	headers map[string]string) (*http.Response, error) {
+	headers = setCustomHeader(headers, a.Headers)
+
+	return sendStream(ctx, a.HTTP, http.MethodPut, path, queryParams, body, headers)
+}
+
+func (a *customHeader) PatchStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody,
+	headers map[string]string) (*http.Response, error) {
+	headers = setCustomHeader(headers, a.Headers)
+
+	return sendStream(ctx, a.HTTP, http.MethodPatch, path, queryParams, body, headers)
+}
+
 func setCustomHeader(headers, customHeader map[string]string) map[string]string {
 	if headers == nil {
 		headers = make(map[string]string)
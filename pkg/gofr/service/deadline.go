@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// TimeoutHeader carries the time left for a request to be served, in milliseconds. It is set on the requests
+// to the services configured with DeadlinePropagationConfig when the context of the call has a deadline, and
+// GoFr servers stop serving a request once the time left has elapsed, so that the work is not continued after
+// the caller has given up on it.
+const TimeoutHeader = "X-Gofr-Timeout"
+
+// DeadlinePropagationConfig sends the time left for the requests to the service in the TimeoutHeader. It is meant
+// for services built with GoFr, so the header is not sent to other services unless they are configured with it.
+type DeadlinePropagationConfig struct{}
+
+// AddOption returns the service as is, as the option is applied by NewHTTPService on the service it creates.
+func (*DeadlinePropagationConfig) AddOption(h HTTP) HTTP {
+	return h
+}
+
+func (*DeadlinePropagationConfig) configureService(h *httpService) {
+	h.propagateDeadline = true
+}
+
+type requestTimeoutKey struct{}
+
+// WithRequestTimeout returns a context for a call to a service which fails the call if the response
+// is not received within the given timeout. Unlike context.WithTimeout, the timeout starts when the request
+// is sent, so that each retried or hedged request gets the whole timeout.
+func WithRequestTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, requestTimeoutKey{}, timeout)
+}
+
+// withRequestTimeout applies the timeout set by WithRequestTimeout on the context of the request.
+func withRequestTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeout, ok := ctx.Value(requestTimeoutKey{}).(time.Duration)
+	if !ok || timeout <= 0 {
+		return ctx, func() {}
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
+
+// setTimeoutHeader propagates the time left for the request to the service, considering the deadline of
+// its context and the timeout of the client, unless the header is already set.
+func setTimeoutHeader(req *http.Request, clientTimeout time.Duration) {
+	if req.Header.Get(TimeoutHeader) != "" {
+		return
+	}
+
+	remaining := clientTimeout
+
+	if deadline, ok := req.Context().Deadline(); ok {
+		if untilDeadline := time.Until(deadline); remaining <= 0 || untilDeadline < remaining {
+			remaining = untilDeadline
+		}
+	}
+
+	if remaining <= 0 {
+		return
+	}
+
+	// the time left is rounded up, so that a request with less than a millisecond left is not sent without a timeout.
+	req.Header.Set(TimeoutHeader, strconv.FormatInt((remaining+time.Millisecond-1).Milliseconds(), 10))
+}
+
+// ParseTimeoutHeader returns the time left for the request as propagated in the TimeoutHeader,
+// and false if the header is not set or is invalid.
+func ParseTimeoutHeader(r *http.Request) (time.Duration, bool) {
+	value := r.Header.Get(TimeoutHeader)
+	if value == "" {
+		return 0, false
+	}
+
+	ms, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || ms <= 0 {
+		return 0, false
+	}
+
+	return time.Duration(ms) * time.Millisecond, true
+}
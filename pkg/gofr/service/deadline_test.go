@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gofr.dev/pkg/gofr/logging"
+)
+
+func TestHTTPService_PropagatesTimeout(t *testing.T) {
+	headers := make(chan string, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		headers <- r.Header.Get(TimeoutHeader)
+	}))
+	defer server.Close()
+
+	svc := NewHTTPService(server.URL, logging.NewMockLogger(logging.ERROR), nil, &DeadlinePropagationConfig{})
+
+	ctx, cancel := context.WithTimeout(t.Context(), 2*time.Second)
+	defer cancel()
+
+	resp, err := svc.Get(ctx, "test", nil)
+	require.NoError(t, err)
+
+	resp.Body.Close()
+
+	remaining, err := strconv.Atoi(<-headers)
+	require.NoError(t, err)
+	assert.LessOrEqual(t, remaining, 2000)
+	assert.Greater(t, remaining, 1000)
+
+	// no time left is propagated without a deadline.
+	resp, err = svc.Get(t.Context(), "test", nil)
+	require.NoError(t, err)
+
+	resp.Body.Close()
+
+	assert.Empty(t, <-headers)
+}
+
+func TestHTTPService_TimeoutNotPropagatedByDefault(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get(TimeoutHeader))
+	}))
+	defer server.Close()
+
+	svc := NewHTTPService(server.URL, logging.NewMockLogger(logging.ERROR), nil)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 2*time.Second)
+	defer cancel()
+
+	resp, err := svc.Get(ctx, "test", nil)
+	require.NoError(t, err)
+
+	resp.Body.Close()
+}
+
+func TestHTTPService_RequestTimeout(t *testing.T) {
+	release := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			<-release
+		}
+
+		_, _ = w.Write([]byte(r.Header.Get(TimeoutHeader)))
+	}))
+	defer server.Close()
+	defer close(release)
+
+	svc := NewHTTPService(server.URL, logging.NewMockLogger(logging.ERROR), nil, &DeadlinePropagationConfig{})
+
+	_, err := svc.Get(WithRequestTimeout(t.Context(), 50*time.Millisecond), "slow", nil) //nolint:bodyclose // no response on error
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	resp, err := svc.Get(WithRequestTimeout(t.Context(), time.Minute), "fast", nil)
+
+	remaining, err := strconv.Atoi(readResponse(t, resp, err))
+	require.NoError(t, err)
+	assert.Greater(t, remaining, 50000)
+}
+
+func TestSetTimeoutHeader(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), time.Minute)
+	defer cancel()
+
+	testCases := []struct {
+		desc          string
+		ctx           context.Context
+		clientTimeout time.Duration
+		header        string
+		expected      string
+	}{
+		{desc: "no deadline", ctx: t.Context()},
+		{desc: "client timeout", ctx: t.Context(), clientTimeout: 3 * time.Second, expected: "3000"},
+		{desc: "client timeout shorter than deadline", ctx: ctx, clientTimeout: 3 * time.Second, expected: "3000"},
+		{desc: "header set by caller", ctx: ctx, header: "100", expected: "100"},
+	}
+
+	for i, tc := range testCases {
+		req := httptest.NewRequestWithContext(tc.ctx, http.MethodGet, "/", http.NoBody)
+		if tc.header != "" {
+			req.Header.Set(TimeoutHeader, tc.header)
+		}
+
+		setTimeoutHeader(req, tc.clientTimeout)
+
+		assert.Equal(t, tc.expected, req.Header.Get(TimeoutHeader), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestParseTimeoutHeader(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+
+	_, ok := ParseTimeoutHeader(req)
+	assert.False(t, ok)
+
+	req.Header.Set(TimeoutHeader, "1500")
+
+	timeout, ok := ParseTimeoutHeader(req)
+	assert.True(t, ok)
+	assert.Equal(t, 1500*time.Millisecond, timeout)
+
+	req.Header.Set(TimeoutHeader, "0")
+
+	_, ok = ParseTimeoutHeader(req)
+	assert.False(t, ok)
+}
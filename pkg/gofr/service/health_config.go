@@ -1,6 +1,9 @@
 package service
 
-import "context"
+import (
+	"context"
+	"net/http"
+)
 
 type HealthConfig struct {
 	HealthEndpoint string
@@ -29,3 +32,18 @@ type customHealthService struct {
 func (c *customHealthService) HealthCheck(ctx context.Context) *Health {
 	return c.HTTP.getHealthResponseForEndpoint(ctx, c.healthEndpoint, c.timeout)
 }
+
+func (c *customHealthService) PostStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody,
+	headers map[string]string) (*http.Response, error) {
+	return sendStream(ctx, c.HTTP, http.MethodPost, path, queryParams, body, headers)
+}
+
+func (c *customHealthService) PutStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody,
+	headers map[string]string) (*http.Response, error) {
+	return sendStream(ctx, c.HTTP, http.MethodPut, path, queryParams, body, headers)
+}
+
+func (c *customHealthService) PatchStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody,
+	headers map[string]string) (*http.Response, error) {
+	return sendStream(ctx, c.HTTP, http.MethodPatch, path, queryParams, body, headers)
+}
@@ -0,0 +1,289 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	defaultMaxHedgedRequests = 1
+	latencySampleSize        = 100
+	minLatencySamples        = 10
+	hedgingPercentile        = 0.95
+)
+
+// HedgingConfig sends hedged GET requests to a service: when a request has not completed after the hedging delay,
+// a duplicate request is sent, to be routed by the load balancer of the service to another instance. The first
+// successful response is returned and the remaining requests are cancelled.
+//
+// Hedging is applied to GET requests only, as they are idempotent. Each hedged request goes through
+// the options passed before HedgingConfig while registering the service.
+type HedgingConfig struct {
+	// Delay is the time after which a hedged request is sent. If it is zero, the delay is the 95th percentile
+	// latency of the recent requests, and requests are not hedged until enough latencies are recorded.
+	Delay time.Duration
+	// MaxHedgedRequests is the maximum number of hedged requests sent in addition to the original one. Defaults to 1.
+	MaxHedgedRequests int
+}
+
+func (hc *HedgingConfig) AddOption(h HTTP) HTTP {
+	return hc.addInstrumentedOption(h, "", nil)
+}
+
+func (hc *HedgingConfig) addInstrumentedOption(h HTTP, serviceAddress string, metrics Metrics) HTTP {
+	maxHedged := hc.MaxHedgedRequests
+	if maxHedged <= 0 {
+		maxHedged = defaultMaxHedgedRequests
+	}
+
+	return &hedging{
+		delay:          hc.Delay,
+		maxHedged:      maxHedged,
+		latencies:      &latencyTracker{samples: make([]time.Duration, 0, latencySampleSize)},
+		serviceAddress: serviceAddress,
+		metrics:        counterMetrics(metrics),
+		HTTP:           h,
+	}
+}
+
+type hedging struct {
+	delay          time.Duration
+	maxHedged      int
+	latencies      *latencyTracker
+	serviceAddress string
+	metrics        CounterMetrics
+
+	HTTP
+}
+
+type hedgedResult struct {
+	resp    *http.Response
+	err     error
+	cancel  context.CancelFunc
+	start   time.Time
+	attempt int
+}
+
+func (r *hedgedResult) succeeded() bool {
+	return r.err == nil && r.resp.StatusCode < http.StatusInternalServerError
+}
+
+func (hd *hedging) Get(ctx context.Context, path string, queryParams map[string]any) (*http.Response, error) {
+	return hd.doHedged(ctx, func(ctx context.Context) (*http.Response, error) {
+		return hd.HTTP.Get(ctx, path, queryParams)
+	})
+}
+
+func (hd *hedging) GetWithHeaders(ctx context.Context, path string, queryParams map[string]any,
+	headers map[string]string) (*http.Response, error) {
+	return hd.doHedged(ctx, func(ctx context.Context) (*http.Response, error) {
+		return hd.HTTP.GetWithHeaders(ctx, path, queryParams, headers)
+	})
+}
+
+func (hd *hedging) PostStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody,
+	headers map[string]string) (*http.Response, error) {
+	return sendStream(ctx, hd.HTTP, http.MethodPost, path, queryParams, body, headers)
+}
+
+func (hd *hedging) PutStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody,
+	headers map[string]string) (*http.Response, error) {
+	return sendStream(ctx, hd.HTTP, http.MethodPut, path, queryParams, body, headers)
+}
+
+func (hd *hedging) PatchStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody,
+	headers map[string]string) (*http.Response, error) {
+	return sendStream(ctx, hd.HTTP, http.MethodPatch, path, queryParams, body, headers)
+}
+
+// hedgingDelay returns the delay after which a hedged request is sent, and false if requests are not to be hedged.
+func (hd *hedging) hedgingDelay() (time.Duration, bool) {
+	if hd.delay > 0 {
+		return hd.delay, true
+	}
+
+	return hd.latencies.percentile(hedgingPercentile)
+}
+
+func (hd *hedging) doHedged(ctx context.Context, reqFunc func(ctx context.Context) (*http.Response, error)) (*http.Response, error) {
+	delay, ok := hd.hedgingDelay()
+	if !ok {
+		start := time.Now()
+
+		resp, err := reqFunc(ctx)
+		if err == nil {
+			hd.latencies.record(time.Since(start))
+		}
+
+		return resp, err
+	}
+
+	results := make(chan *hedgedResult, hd.maxHedged+1)
+	cancels := make([]context.CancelFunc, 0, hd.maxHedged+1)
+
+	send := func(attempt int) {
+		attemptCtx, cancel := context.WithCancel(ctx)
+		cancels = append(cancels, cancel)
+		start := time.Now()
+
+		go func() {
+			resp, err := reqFunc(attemptCtx)
+			results <- &hedgedResult{resp: resp, err: err, cancel: cancel, start: start, attempt: attempt}
+		}()
+	}
+
+	send(0)
+
+	sent, pending := 1, 1
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	var last *hedgedResult
+
+	for pending > 0 {
+		select {
+		case <-timer.C:
+			if sent <= hd.maxHedged {
+				hd.recordHedge(ctx, "sent")
+				send(sent)
+
+				sent++
+				pending++
+
+				timer.Reset(delay)
+			}
+		case result := <-results:
+			pending--
+
+			if result.succeeded() {
+				hd.latencies.record(time.Since(result.start))
+
+				if result.attempt > 0 {
+					hd.recordHedge(ctx, "won")
+				}
+
+				// the requests still in flight are cancelled right away, and released once they return.
+				for i, cancel := range cancels {
+					if i != result.attempt {
+						cancel()
+					}
+				}
+
+				go discardHedgedResults(results, pending)
+
+				return withCancelOnClose(result), nil
+			}
+
+			if last != nil {
+				discardHedgedResult(last)
+			}
+
+			last = result
+
+			// a failed request is hedged right away, if any hedged request is left to be sent.
+			if sent <= hd.maxHedged {
+				hd.recordHedge(ctx, "sent")
+				send(sent)
+
+				sent++
+				pending++
+			}
+		}
+	}
+
+	return withCancelOnClose(last), last.err
+}
+
+func (hd *hedging) recordHedge(ctx context.Context, result string) {
+	if hd.metrics != nil {
+		hd.metrics.IncrementCounter(ctx, "app_http_service_hedge_count", "path", hd.serviceAddress, "result", result)
+	}
+}
+
+// discardHedgedResults cancels and releases the requests which lost the race.
+func discardHedgedResults(results <-chan *hedgedResult, pending int) {
+	for range pending {
+		discardHedgedResult(<-results)
+	}
+}
+
+func discardHedgedResult(result *hedgedResult) {
+	result.cancel()
+
+	if result.resp != nil && result.resp.Body != nil {
+		_, _ = io.Copy(io.Discard, result.resp.Body)
+		result.resp.Body.Close()
+	}
+}
+
+// withCancelOnClose returns the response of the request, releasing its context once the body is closed.
+func withCancelOnClose(result *hedgedResult) *http.Response {
+	if result.resp == nil {
+		result.cancel()
+
+		return nil
+	}
+
+	body := result.resp.Body
+	if body == nil {
+		body = http.NoBody
+	}
+
+	result.resp.Body = &cancelOnCloseBody{ReadCloser: body, cancel: result.cancel}
+
+	return result.resp
+}
+
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+
+	return err
+}
+
+// latencyTracker keeps the latencies of the most recent requests to compute percentiles.
+type latencyTracker struct {
+	mu      sync.Mutex
+	samples []time.Duration
+	next    int
+}
+
+func (l *latencyTracker) record(latency time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.samples) < cap(l.samples) {
+		l.samples = append(l.samples, latency)
+
+		return
+	}
+
+	l.samples[l.next] = latency
+	l.next = (l.next + 1) % len(l.samples)
+}
+
+// percentile returns the latency under which the given fraction of the recent requests completed,
+// and false if not enough latencies are recorded yet.
+func (l *latencyTracker) percentile(p float64) (time.Duration, bool) {
+	l.mu.Lock()
+	sorted := slices.Clone(l.samples)
+	l.mu.Unlock()
+
+	if len(sorted) < minLatencySamples {
+		return 0, false
+	}
+
+	slices.Sort(sorted)
+
+	return sorted[int(p*float64(len(sorted)-1))], true
+}
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var errUpstream = errors.New("upstream error")
+
+// scriptedHTTP responds to each GET request as per the handler of its attempt.
+type scriptedHTTP struct {
+	calls    atomic.Int32
+	handlers []func(ctx context.Context) (*http.Response, error)
+
+	mockHTTP
+}
+
+func (s *scriptedHTTP) Get(ctx context.Context, _ string, _ map[string]any) (*http.Response, error) {
+	attempt := int(s.calls.Add(1)) - 1
+
+	return s.handlers[attempt](ctx)
+}
+
+func respondAfter(delay time.Duration, status int, body string) func(ctx context.Context) (*http.Response, error) {
+	return func(ctx context.Context) (*http.Response, error) {
+		select {
+		case <-time.After(delay):
+			return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body))}, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func TestHedging_HedgedRequestWins(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	metrics := NewMockCounterMetrics(ctrl)
+
+	cancelled := make(chan struct{})
+
+	downstream := &scriptedHTTP{handlers: []func(ctx context.Context) (*http.Response, error){
+		func(ctx context.Context) (*http.Response, error) {
+			<-ctx.Done()
+			close(cancelled)
+
+			return nil, ctx.Err()
+		},
+		respondAfter(0, http.StatusOK, "hedged"),
+	}}
+
+	config := &HedgingConfig{Delay: 10 * time.Millisecond}
+	svc := config.addInstrumentedOption(downstream, "http://test.com", metrics)
+
+	metrics.EXPECT().IncrementCounter(gomock.Any(), "app_http_service_hedge_count", "path", "http://test.com", "result", "sent")
+	metrics.EXPECT().IncrementCounter(gomock.Any(), "app_http_service_hedge_count", "path", "http://test.com", "result", "won")
+
+	resp, err := svc.Get(t.Context(), "test", nil)
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "hedged", string(body))
+
+	resp.Body.Close()
+
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("the losing request was not cancelled")
+	}
+
+	assert.Equal(t, int32(2), downstream.calls.Load())
+}
+
+func TestHedging_FastResponseIsNotHedged(t *testing.T) {
+	downstream := &scriptedHTTP{handlers: []func(ctx context.Context) (*http.Response, error){
+		respondAfter(0, http.StatusOK, "original"),
+	}}
+
+	svc := (&HedgingConfig{Delay: time.Second}).AddOption(downstream)
+
+	resp, err := svc.Get(t.Context(), "test", nil)
+	require.NoError(t, err)
+
+	resp.Body.Close()
+
+	assert.Equal(t, int32(1), downstream.calls.Load())
+}
+
+func TestHedging_FailureIsHedgedImmediately(t *testing.T) {
+	downstream := &scriptedHTTP{handlers: []func(ctx context.Context) (*http.Response, error){
+		func(context.Context) (*http.Response, error) { return nil, errUpstream },
+		respondAfter(0, http.StatusOK, "hedged"),
+	}}
+
+	svc := (&HedgingConfig{Delay: time.Minute}).AddOption(downstream)
+
+	resp, err := svc.Get(t.Context(), "test", nil)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestHedging_AllRequestsFail(t *testing.T) {
+	downstream := &scriptedHTTP{handlers: []func(ctx context.Context) (*http.Response, error){
+		respondAfter(0, http.StatusInternalServerError, "first"),
+		respondAfter(0, http.StatusServiceUnavailable, "second"),
+	}}
+
+	svc := (&HedgingConfig{Delay: time.Minute}).AddOption(downstream)
+
+	resp, err := svc.Get(t.Context(), "test", nil)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, int32(2), downstream.calls.Load())
+}
+
+func TestHedging_LearnedDelay(t *testing.T) {
+	handlers := make([]func(ctx context.Context) (*http.Response, error), 0, minLatencySamples+2)
+
+	for range minLatencySamples {
+		handlers = append(handlers, respondAfter(time.Millisecond, http.StatusOK, "warmup"))
+	}
+
+	handlers = append(handlers, respondAfter(time.Minute, http.StatusOK, "slow"), respondAfter(0, http.StatusOK, "hedged"))
+
+	downstream := &scriptedHTTP{handlers: handlers}
+	svc := (&HedgingConfig{}).AddOption(downstream)
+
+	// requests are not hedged until enough latencies are recorded.
+	for range minLatencySamples {
+		resp, err := svc.Get(t.Context(), "test", nil)
+		require.NoError(t, err)
+
+		resp.Body.Close()
+	}
+
+	resp, err := svc.Get(t.Context(), "test", nil)
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "hedged", string(body))
+
+	resp.Body.Close()
+}
+
+func TestHedging_OtherMethodsAreNotHedged(t *testing.T) {
+	svc := (&HedgingConfig{Delay: time.Nanosecond}).AddOption(&mockHTTP{})
+
+	resp, err := svc.Post(t.Context(), "test", nil, nil)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+}
+
+func TestLatencyTracker(t *testing.T) {
+	tracker := &latencyTracker{samples: make([]time.Duration, 0, 20)}
+
+	_, ok := tracker.percentile(hedgingPercentile)
+	assert.False(t, ok)
+
+	for i := 1; i <= 40; i++ {
+		tracker.record(time.Duration(i) * time.Millisecond)
+	}
+
+	// only the most recent 20 latencies, 21ms to 40ms, are kept.
+	p95, ok := tracker.percentile(hedgingPercentile)
+
+	assert.True(t, ok)
+	assert.Equal(t, 39*time.Millisecond, p95)
+}
@@ -5,3 +5,18 @@ import "context"
 type Metrics interface {
 	RecordHistogram(ctx context.Context, name string, value float64, labels ...string)
 }
+
+// CounterMetrics is implemented by the Metrics which also record counters, e.g. the metrics of the app. The options
+// recording counters, such as the bulkhead and the rate limiter, record them only when the Metrics implement it.
+type CounterMetrics interface {
+	Metrics
+	IncrementCounter(ctx context.Context, name string, labels ...string)
+	DeltaUpDownCounter(ctx context.Context, name string, value float64, labels ...string)
+}
+
+// counterMetrics returns the metrics as CounterMetrics, or nil when they do not record counters.
+func counterMetrics(metrics Metrics) CounterMetrics {
+	cm, _ := metrics.(CounterMetrics)
+
+	return cm
+}
@@ -21,6 +21,7 @@ import (
 type MockHTTP struct {
 	ctrl     *gomock.Controller
 	recorder *MockHTTPMockRecorder
+	isgomock struct{}
 }
 
 // MockHTTPMockRecorder is the mock recorder for MockHTTP.
@@ -222,6 +223,7 @@ func (mr *MockHTTPMockRecorder) getHealthResponseForEndpoint(ctx, endpoint, time
 type MockhttpClient struct {
 	ctrl     *gomock.Controller
 	recorder *MockhttpClientMockRecorder
+	isgomock struct{}
 }
 
 // MockhttpClientMockRecorder is the mock recorder for MockhttpClient.
@@ -390,3 +392,250 @@ func (mr *MockhttpClientMockRecorder) PutWithHeaders(ctx, api, queryParams, body
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutWithHeaders", reflect.TypeOf((*MockhttpClient)(nil).PutWithHeaders), ctx, api, queryParams, body, headers)
 }
+
+// MockStreamingHTTP is a mock of StreamingHTTP interface.
+type MockStreamingHTTP struct {
+	ctrl     *gomock.Controller
+	recorder *MockStreamingHTTPMockRecorder
+	isgomock struct{}
+}
+
+// MockStreamingHTTPMockRecorder is the mock recorder for MockStreamingHTTP.
+type MockStreamingHTTPMockRecorder struct {
+	mock *MockStreamingHTTP
+}
+
+// NewMockStreamingHTTP creates a new mock instance.
+func NewMockStreamingHTTP(ctrl *gomock.Controller) *MockStreamingHTTP {
+	mock := &MockStreamingHTTP{ctrl: ctrl}
+	mock.recorder = &MockStreamingHTTPMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStreamingHTTP) EXPECT() *MockStreamingHTTPMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockStreamingHTTP) Delete(ctx context.Context, api string, body []byte) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, api, body)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockStreamingHTTPMockRecorder) Delete(ctx, api, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStreamingHTTP)(nil).Delete), ctx, api, body)
+}
+
+// DeleteWithHeaders mocks base method.
+func (m *MockStreamingHTTP) DeleteWithHeaders(ctx context.Context, api string, body []byte, headers map[string]string) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWithHeaders", ctx, api, body, headers)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWithHeaders indicates an expected call of DeleteWithHeaders.
+func (mr *MockStreamingHTTPMockRecorder) DeleteWithHeaders(ctx, api, body, headers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWithHeaders", reflect.TypeOf((*MockStreamingHTTP)(nil).DeleteWithHeaders), ctx, api, body, headers)
+}
+
+// Get mocks base method.
+func (m *MockStreamingHTTP) Get(ctx context.Context, api string, queryParams map[string]any) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, api, queryParams)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockStreamingHTTPMockRecorder) Get(ctx, api, queryParams any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStreamingHTTP)(nil).Get), ctx, api, queryParams)
+}
+
+// GetWithHeaders mocks base method.
+func (m *MockStreamingHTTP) GetWithHeaders(ctx context.Context, path string, queryParams map[string]any, headers map[string]string) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithHeaders", ctx, path, queryParams, headers)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithHeaders indicates an expected call of GetWithHeaders.
+func (mr *MockStreamingHTTPMockRecorder) GetWithHeaders(ctx, path, queryParams, headers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithHeaders", reflect.TypeOf((*MockStreamingHTTP)(nil).GetWithHeaders), ctx, path, queryParams, headers)
+}
+
+// HealthCheck mocks base method.
+func (m *MockStreamingHTTP) HealthCheck(ctx context.Context) *Health {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HealthCheck", ctx)
+	ret0, _ := ret[0].(*Health)
+	return ret0
+}
+
+// HealthCheck indicates an expected call of HealthCheck.
+func (mr *MockStreamingHTTPMockRecorder) HealthCheck(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthCheck", reflect.TypeOf((*MockStreamingHTTP)(nil).HealthCheck), ctx)
+}
+
+// Patch mocks base method.
+func (m *MockStreamingHTTP) Patch(ctx context.Context, api string, queryParams map[string]any, body []byte) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", ctx, api, queryParams, body)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockStreamingHTTPMockRecorder) Patch(ctx, api, queryParams, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockStreamingHTTP)(nil).Patch), ctx, api, queryParams, body)
+}
+
+// PatchStream mocks base method.
+func (m *MockStreamingHTTP) PatchStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody, headers map[string]string) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchStream", ctx, path, queryParams, body, headers)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchStream indicates an expected call of PatchStream.
+func (mr *MockStreamingHTTPMockRecorder) PatchStream(ctx, path, queryParams, body, headers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchStream", reflect.TypeOf((*MockStreamingHTTP)(nil).PatchStream), ctx, path, queryParams, body, headers)
+}
+
+// PatchWithHeaders mocks base method.
+func (m *MockStreamingHTTP) PatchWithHeaders(ctx context.Context, api string, queryParams map[string]any, body []byte, headers map[string]string) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchWithHeaders", ctx, api, queryParams, body, headers)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchWithHeaders indicates an expected call of PatchWithHeaders.
+func (mr *MockStreamingHTTPMockRecorder) PatchWithHeaders(ctx, api, queryParams, body, headers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchWithHeaders", reflect.TypeOf((*MockStreamingHTTP)(nil).PatchWithHeaders), ctx, api, queryParams, body, headers)
+}
+
+// Post mocks base method.
+func (m *MockStreamingHTTP) Post(ctx context.Context, path string, queryParams map[string]any, body []byte) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Post", ctx, path, queryParams, body)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Post indicates an expected call of Post.
+func (mr *MockStreamingHTTPMockRecorder) Post(ctx, path, queryParams, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*MockStreamingHTTP)(nil).Post), ctx, path, queryParams, body)
+}
+
+// PostStream mocks base method.
+func (m *MockStreamingHTTP) PostStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody, headers map[string]string) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostStream", ctx, path, queryParams, body, headers)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostStream indicates an expected call of PostStream.
+func (mr *MockStreamingHTTPMockRecorder) PostStream(ctx, path, queryParams, body, headers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostStream", reflect.TypeOf((*MockStreamingHTTP)(nil).PostStream), ctx, path, queryParams, body, headers)
+}
+
+// PostWithHeaders mocks base method.
+func (m *MockStreamingHTTP) PostWithHeaders(ctx context.Context, path string, queryParams map[string]any, body []byte, headers map[string]string) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostWithHeaders", ctx, path, queryParams, body, headers)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostWithHeaders indicates an expected call of PostWithHeaders.
+func (mr *MockStreamingHTTPMockRecorder) PostWithHeaders(ctx, path, queryParams, body, headers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostWithHeaders", reflect.TypeOf((*MockStreamingHTTP)(nil).PostWithHeaders), ctx, path, queryParams, body, headers)
+}
+
+// Put mocks base method.
+func (m *MockStreamingHTTP) Put(ctx context.Context, api string, queryParams map[string]any, body []byte) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, api, queryParams, body)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockStreamingHTTPMockRecorder) Put(ctx, api, queryParams, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockStreamingHTTP)(nil).Put), ctx, api, queryParams, body)
+}
+
+// PutStream mocks base method.
+func (m *MockStreamingHTTP) PutStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody, headers map[string]string) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutStream", ctx, path, queryParams, body, headers)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutStream indicates an expected call of PutStream.
+func (mr *MockStreamingHTTPMockRecorder) PutStream(ctx, path, queryParams, body, headers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutStream", reflect.TypeOf((*MockStreamingHTTP)(nil).PutStream), ctx, path, queryParams, body, headers)
+}
+
+// PutWithHeaders mocks base method.
+func (m *MockStreamingHTTP) PutWithHeaders(ctx context.Context, api string, queryParams map[string]any, body []byte, headers map[string]string) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutWithHeaders", ctx, api, queryParams, body, headers)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutWithHeaders indicates an expected call of PutWithHeaders.
+func (mr *MockStreamingHTTPMockRecorder) PutWithHeaders(ctx, api, queryParams, body, headers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutWithHeaders", reflect.TypeOf((*MockStreamingHTTP)(nil).PutWithHeaders), ctx, api, queryParams, body, headers)
+}
+
+// getHealthResponseForEndpoint mocks base method.
+func (m *MockStreamingHTTP) getHealthResponseForEndpoint(ctx context.Context, endpoint string, timeout int) *Health {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "getHealthResponseForEndpoint", ctx, endpoint, timeout)
+	ret0, _ := ret[0].(*Health)
+	return ret0
+}
+
+// getHealthResponseForEndpoint indicates an expected call of getHealthResponseForEndpoint.
+func (mr *MockStreamingHTTPMockRecorder) getHealthResponseForEndpoint(ctx, endpoint, timeout any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getHealthResponseForEndpoint", reflect.TypeOf((*MockStreamingHTTP)(nil).getHealthResponseForEndpoint), ctx, endpoint, timeout)
+}
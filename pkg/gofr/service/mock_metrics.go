@@ -20,6 +20,7 @@ import (
 type MockMetrics struct {
 	ctrl     *gomock.Controller
 	recorder *MockMetricsMockRecorder
+	isgomock struct{}
 }
 
 // MockMetricsMockRecorder is the mock recorder for MockMetrics.
@@ -55,3 +56,78 @@ func (mr *MockMetricsMockRecorder) RecordHistogram(ctx, name, value any, labels
 	varargs := append([]any{ctx, name, value}, labels...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordHistogram", reflect.TypeOf((*MockMetrics)(nil).RecordHistogram), varargs...)
 }
+
+// MockCounterMetrics is a mock of CounterMetrics interface.
+type MockCounterMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockCounterMetricsMockRecorder
+	isgomock struct{}
+}
+
+// MockCounterMetricsMockRecorder is the mock recorder for MockCounterMetrics.
+type MockCounterMetricsMockRecorder struct {
+	mock *MockCounterMetrics
+}
+
+// NewMockCounterMetrics creates a new mock instance.
+func NewMockCounterMetrics(ctrl *gomock.Controller) *MockCounterMetrics {
+	mock := &MockCounterMetrics{ctrl: ctrl}
+	mock.recorder = &MockCounterMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCounterMetrics) EXPECT() *MockCounterMetricsMockRecorder {
+	return m.recorder
+}
+
+// DeltaUpDownCounter mocks base method.
+func (m *MockCounterMetrics) DeltaUpDownCounter(ctx context.Context, name string, value float64, labels ...string) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name, value}
+	for _, a := range labels {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "DeltaUpDownCounter", varargs...)
+}
+
+// DeltaUpDownCounter indicates an expected call of DeltaUpDownCounter.
+func (mr *MockCounterMetricsMockRecorder) DeltaUpDownCounter(ctx, name, value any, labels ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name, value}, labels...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeltaUpDownCounter", reflect.TypeOf((*MockCounterMetrics)(nil).DeltaUpDownCounter), varargs...)
+}
+
+// IncrementCounter mocks base method.
+func (m *MockCounterMetrics) IncrementCounter(ctx context.Context, name string, labels ...string) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range labels {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "IncrementCounter", varargs...)
+}
+
+// IncrementCounter indicates an expected call of IncrementCounter.
+func (mr *MockCounterMetricsMockRecorder) IncrementCounter(ctx, name any, labels ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, labels...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementCounter", reflect.TypeOf((*MockCounterMetrics)(nil).IncrementCounter), varargs...)
+}
+
+// RecordHistogram mocks base method.
+func (m *MockCounterMetrics) RecordHistogram(ctx context.Context, name string, value float64, labels ...string) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name, value}
+	for _, a := range labels {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "RecordHistogram", varargs...)
+}
+
+// RecordHistogram indicates an expected call of RecordHistogram.
+func (mr *MockCounterMetricsMockRecorder) RecordHistogram(ctx, name, value any, labels ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name, value}, labels...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordHistogram", reflect.TypeOf((*MockCounterMetrics)(nil).RecordHistogram), varargs...)
+}
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptrace"
+	"net/url"
 	"strings"
 	"time"
 
@@ -21,6 +23,8 @@ type httpService struct {
 	url string
 	Logger
 	Metrics
+
+	propagateDeadline bool
 }
 
 type HTTP interface {
@@ -63,6 +67,22 @@ type httpClient interface {
 	DeleteWithHeaders(ctx context.Context, api string, body []byte, headers map[string]string) (*http.Response, error)
 }
 
+// StreamingHTTP is implemented by the HTTP services which can stream request bodies instead of buffering them
+// in memory. The services created by NewHTTPService implement it, and callers type-assert HTTP to use it.
+type StreamingHTTP interface {
+	HTTP
+
+	// PostStream performs an HTTP POST request streaming the body from body.Reader instead of buffering it in memory.
+	PostStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody,
+		headers map[string]string) (*http.Response, error)
+	// PutStream performs an HTTP PUT request streaming the body from body.Reader instead of buffering it in memory.
+	PutStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody,
+		headers map[string]string) (*http.Response, error)
+	// PatchStream performs an HTTP PATCH request streaming the body from body.Reader instead of buffering it in memory.
+	PatchStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody,
+		headers map[string]string) (*http.Response, error)
+}
+
 // NewHTTPService function creates a new instance of the httpService struct, which implements the HTTP interface.
 // It initializes the http.Client, url, Tracer, and Logger fields of the httpService struct with the provided values.
 func NewHTTPService(serviceAddress string, logger Logger, metrics Metrics, options ...Options) HTTP {
@@ -79,14 +99,46 @@ func NewHTTPService(serviceAddress string, logger Logger, metrics Metrics, optio
 
 	svc = h
 
+	// the client is configured before the options wrapping the service are applied.
+	configureClient(h.Client, options)
+
 	// if options are given, then add them to the httpService struct
 	for _, o := range options {
-		svc = o.AddOption(svc)
+		switch opt := o.(type) {
+		case clientOption:
+			continue
+		case serviceOption:
+			opt.configureService(h)
+		case instrumentedOption:
+			svc = opt.addInstrumentedOption(svc, serviceAddress, metrics)
+		default:
+			svc = o.AddOption(svc)
+		}
 	}
 
 	return svc
 }
 
+// configureClient applies the options configuring the client, starting with the transport
+// as the other options build on top of it.
+func configureClient(client *http.Client, options []Options) {
+	for _, o := range options {
+		if t, ok := o.(*TransportConfig); ok {
+			t.configureClient(client)
+		}
+	}
+
+	for _, o := range options {
+		if _, ok := o.(*TransportConfig); ok {
+			continue
+		}
+
+		if c, ok := o.(clientOption); ok {
+			c.configureClient(client)
+		}
+	}
+}
+
 func (h *httpService) Get(ctx context.Context, path string, queryParams map[string]any) (*http.Response, error) {
 	return h.GetWithHeaders(ctx, path, queryParams, nil)
 }
@@ -133,8 +185,62 @@ func (h *httpService) DeleteWithHeaders(ctx context.Context, path string, body [
 	return h.createAndSendRequest(ctx, http.MethodDelete, path, nil, body, headers)
 }
 
+func (h *httpService) PostStream(ctx context.Context, path string, queryParams map[string]any,
+	body RequestBody, headers map[string]string) (*http.Response, error) {
+	return h.createAndSendStreamRequest(ctx, http.MethodPost, path, queryParams, body, headers)
+}
+
+func (h *httpService) PutStream(ctx context.Context, path string, queryParams map[string]any,
+	body RequestBody, headers map[string]string) (*http.Response, error) {
+	return h.createAndSendStreamRequest(ctx, http.MethodPut, path, queryParams, body, headers)
+}
+
+func (h *httpService) PatchStream(ctx context.Context, path string, queryParams map[string]any,
+	body RequestBody, headers map[string]string) (*http.Response, error) {
+	return h.createAndSendStreamRequest(ctx, http.MethodPatch, path, queryParams, body, headers)
+}
+
 func (h *httpService) createAndSendRequest(ctx context.Context, method string, path string,
 	queryParams map[string]any, body []byte, headers map[string]string) (*http.Response, error) {
+	return h.sendRequest(ctx, method, path, queryParams, bytes.NewBuffer(body), 0, "application/json", headers)
+}
+
+func (h *httpService) createAndSendStreamRequest(ctx context.Context, method string, path string,
+	queryParams map[string]any, body RequestBody, headers map[string]string) (*http.Response, error) {
+	contentType := body.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	return h.sendRequest(ctx, method, path, queryParams, body.Reader, body.ContentLength, contentType, headers)
+}
+
+// sendRequest sends the request to the service within the timeout set by WithRequestTimeout, which is
+// released once the body of the response is closed.
+func (h *httpService) sendRequest(ctx context.Context, method string, path string, queryParams map[string]any,
+	body io.Reader, contentLength int64, contentType string, headers map[string]string) (*http.Response, error) {
+	ctx, cancel := withRequestTimeout(ctx)
+
+	resp, err := h.send(ctx, method, path, queryParams, body, contentLength, contentType, headers)
+	if resp == nil {
+		cancel()
+
+		return resp, err
+	}
+
+	if resp.Body == nil {
+		resp.Body = http.NoBody
+	}
+
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, err
+}
+
+// send sends the request to the service with tracing, logging and metrics. The content type is used
+// unless set in the headers, and the content length is detected from the body if not positive.
+func (h *httpService) send(ctx context.Context, method string, path string, queryParams map[string]any,
+	body io.Reader, contentLength int64, contentType string, headers map[string]string) (*http.Response, error) {
 	uri := h.url + "/" + path
 	uri = strings.TrimRight(uri, "/")
 
@@ -145,11 +251,17 @@ func (h *httpService) createAndSendRequest(ctx context.Context, method string, p
 	clientTraceCtx := httptrace.WithClientTrace(ctx, otelhttptrace.NewClientTrace(ctx))
 
 	// Create the HTTP request with the tracing context.
-	req, err := http.NewRequestWithContext(clientTraceCtx, method, uri, bytes.NewBuffer(body))
+	req, err := http.NewRequestWithContext(clientTraceCtx, method, uri, body)
 	if err != nil {
+		closeBody(body)
+
 		return nil, err
 	}
 
+	if contentLength > 0 {
+		req.ContentLength = contentLength
+	}
+
 	var isContentTypeSet bool
 
 	for k, v := range headers {
@@ -161,7 +273,12 @@ func (h *httpService) createAndSendRequest(ctx context.Context, method string, p
 	}
 
 	if !isContentTypeSet {
-		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	// propagate the time left for the request, so that the service does not work on it after it has timed out.
+	if h.propagateDeadline {
+		setTimeoutHeader(req, h.Client.Timeout)
 	}
 
 	// Inject tracing information into the request headers.
@@ -212,6 +329,12 @@ func (h *httpService) updateMetrics(ctx context.Context, method string, timeTake
 func encodeQueryParameters(req *http.Request, queryParams map[string]any) {
 	q := req.URL.Query()
 
+	addQueryParameters(q, queryParams)
+
+	req.URL.RawQuery = q.Encode()
+}
+
+func addQueryParameters(q url.Values, queryParams map[string]any) {
 	for k, v := range queryParams {
 		switch vt := v.(type) {
 		case []string:
@@ -222,6 +345,4 @@ func encodeQueryParameters(req *http.Request, queryParams map[string]any) {
 			q.Set(k, fmt.Sprintf("%v", v))
 		}
 	}
-
-	req.URL.RawQuery = q.Encode()
 }
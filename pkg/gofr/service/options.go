@@ -1,5 +1,25 @@
 package service
 
+import "net/http"
+
 type Options interface {
 	AddOption(h HTTP) HTTP
 }
+
+// instrumentedOption is implemented by Options which report their own metrics. NewHTTPService applies
+// them with the address and metrics of the service being created instead of calling AddOption.
+type instrumentedOption interface {
+	addInstrumentedOption(h HTTP, serviceAddress string, metrics Metrics) HTTP
+}
+
+// clientOption is implemented by Options which configure the http.Client of the service instead of wrapping it.
+// NewHTTPService applies them before the options wrapping the service, irrespective of their order.
+type clientOption interface {
+	configureClient(client *http.Client)
+}
+
+// serviceOption is implemented by Options which configure the httpService created by NewHTTPService instead of
+// wrapping it. NewHTTPService applies them irrespective of their order.
+type serviceOption interface {
+	configureService(h *httpService)
+}
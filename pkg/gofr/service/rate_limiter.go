@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// ErrRateLimitExceeded is returned when a request to a service would exceed the configured client-side rate limit.
+var ErrRateLimitExceeded = errors.New("rate limit for service exceeded")
+
+// RateLimiterConfig limits the rate at which requests are sent to a service using a token bucket.
+type RateLimiterConfig struct {
+	// RequestsPerSecond is the number of requests allowed per second on average.
+	RequestsPerSecond float64
+	// Burst is the maximum number of requests allowed to be sent at once. Defaults to 1.
+	Burst int
+	// MaxWait is the maximum time a request waits for its turn before failing with ErrRateLimitExceeded.
+	// A zero value rejects requests immediately when the limit is reached.
+	MaxWait time.Duration
+}
+
+func (r *RateLimiterConfig) AddOption(h HTTP) HTTP {
+	return r.addInstrumentedOption(h, "", nil)
+}
+
+func (r *RateLimiterConfig) addInstrumentedOption(h HTTP, serviceAddress string, metrics Metrics) HTTP {
+	if r.RequestsPerSecond <= 0 {
+		return h
+	}
+
+	burst := r.Burst
+	if burst <= 0 {
+		burst = 1
+	}
+
+	return &rateLimiter{
+		limiter:        rate.NewLimiter(rate.Limit(r.RequestsPerSecond), burst),
+		maxWait:        r.MaxWait,
+		serviceAddress: serviceAddress,
+		metrics:        counterMetrics(metrics),
+		HTTP:           h,
+	}
+}
+
+type rateLimiter struct {
+	limiter        *rate.Limiter
+	maxWait        time.Duration
+	serviceAddress string
+	metrics        CounterMetrics
+
+	HTTP
+}
+
+// wait blocks until the request is allowed by the limiter, or fails if that would take longer than maxWait.
+func (rl *rateLimiter) wait(ctx context.Context) error {
+	reservation := rl.limiter.Reserve()
+
+	delay := reservation.Delay()
+	if delay == 0 {
+		return nil
+	}
+
+	if delay > rl.maxWait {
+		reservation.Cancel()
+
+		return ErrRateLimitExceeded
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		reservation.Cancel()
+
+		return ctx.Err()
+	}
+}
+
+func (rl *rateLimiter) doRequest(ctx context.Context, method string, reqFunc func() (*http.Response, error)) (*http.Response, error) {
+	if err := rl.wait(ctx); err != nil {
+		if errors.Is(err, ErrRateLimitExceeded) && rl.metrics != nil {
+			rl.metrics.IncrementCounter(ctx, "app_http_service_rejected_count", "path", rl.serviceAddress,
+				"method", method, "reason", "rate_limit")
+		}
+
+		return nil, err
+	}
+
+	return reqFunc()
+}
+
+func (rl *rateLimiter) Get(ctx context.Context, path string, queryParams map[string]any) (*http.Response, error) {
+	return rl.doRequest(ctx, http.MethodGet, func() (*http.Response, error) {
+		return rl.HTTP.Get(ctx, path, queryParams)
+	})
+}
+
+func (rl *rateLimiter) GetWithHeaders(ctx context.Context, path string, queryParams map[string]any,
+	headers map[string]string) (*http.Response, error) {
+	return rl.doRequest(ctx, http.MethodGet, func() (*http.Response, error) {
+		return rl.HTTP.GetWithHeaders(ctx, path, queryParams, headers)
+	})
+}
+
+func (rl *rateLimiter) Post(ctx context.Context, path string, queryParams map[string]any,
+	body []byte) (*http.Response, error) {
+	return rl.doRequest(ctx, http.MethodPost, func() (*http.Response, error) {
+		return rl.HTTP.Post(ctx, path, queryParams, body)
+	})
+}
+
+func (rl *rateLimiter) PostWithHeaders(ctx context.Context, path string, queryParams map[string]any, body []byte,
+	headers map[string]string) (*http.Response, error) {
+	return rl.doRequest(ctx, http.MethodPost, func() (*http.Response, error) {
+		return rl.HTTP.PostWithHeaders(ctx, path, queryParams, body, headers)
+	})
+}
+
+func (rl *rateLimiter) Put(ctx context.Context, path string, queryParams map[string]any, body []byte) (
+	*http.Response, error) {
+	return rl.doRequest(ctx, http.MethodPut, func() (*http.Response, error) {
+		return rl.HTTP.Put(ctx, path, queryParams, body)
+	})
+}
+
+func (rl *rateLimiter) PutWithHeaders(ctx context.Context, path string, queryParams map[string]any, body []byte,
+	headers map[string]string) (*http.Response, error) {
+	return rl.doRequest(ctx, http.MethodPut, func() (*http.Response, error) {
+		return rl.HTTP.PutWithHeaders(ctx, path, queryParams, body, headers)
+	})
+}
+
+func (rl *rateLimiter) Patch(ctx context.Context, path string, queryParams map[string]any, body []byte) (
+	*http.Response, error) {
+	return rl.doRequest(ctx, http.MethodPatch, func() (*http.Response, error) {
+		return rl.HTTP.Patch(ctx, path, queryParams, body)
+	})
+}
+
+func (rl *rateLimiter) PatchWithHeaders(ctx context.Context, path string, queryParams map[string]any, body []byte,
+	headers map[string]string) (*http.Response, error) {
+	return rl.doRequest(ctx, http.MethodPatch, func() (*http.Response, error) {
+		return rl.HTTP.PatchWithHeaders(ctx, path, queryParams, body, headers)
+	})
+}
+
+func (rl *rateLimiter) Delete(ctx context.Context, path string, body []byte) (*http.Response, error) {
+	return rl.doRequest(ctx, http.MethodDelete, func() (*http.Response, error) {
+		return rl.HTTP.Delete(ctx, path, body)
+	})
+}
+
+func (rl *rateLimiter) DeleteWithHeaders(ctx context.Context, path string, body []byte, headers map[string]string) (
+	*http.Response, error) {
+	return rl.doRequest(ctx, http.MethodDelete, func() (*http.Response, error) {
+		return rl.HTTP.DeleteWithHeaders(ctx, path, body, headers)
+	})
+}
+
+func (rl *rateLimiter) PostStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody,
+	headers map[string]string) (*http.Response, error) {
+	resp, err := rl.doRequest(ctx, http.MethodPost, func() (*http.Response, error) {
+		return sendStream(ctx, rl.HTTP, http.MethodPost, path, queryParams, body, headers)
+	})
+	if err != nil && resp == nil {
+		closeBody(body.Reader)
+	}
+
+	return resp, err
+}
+
+func (rl *rateLimiter) PutStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody,
+	headers map[string]string) (*http.Response, error) {
+	resp, err := rl.doRequest(ctx, http.MethodPut, func() (*http.Response, error) {
+		return sendStream(ctx, rl.HTTP, http.MethodPut, path, queryParams, body, headers)
+	})
+	if err != nil && resp == nil {
+		closeBody(body.Reader)
+	}
+
+	return resp, err
+}
+
+func (rl *rateLimiter) PatchStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody,
+	headers map[string]string) (*http.Response, error) {
+	resp, err := rl.doRequest(ctx, http.MethodPatch, func() (*http.Response, error) {
+		return sendStream(ctx, rl.HTTP, http.MethodPatch, path, queryParams, body, headers)
+	})
+	if err != nil && resp == nil {
+		closeBody(body.Reader)
+	}
+
+	return resp, err
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRateLimiter_RejectsWhenLimitExceeded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	metrics := NewMockCounterMetrics(ctrl)
+
+	config := &RateLimiterConfig{RequestsPerSecond: 1, Burst: 1}
+	svc := config.addInstrumentedOption(&mockHTTP{}, "http://test.com", metrics)
+
+	metrics.EXPECT().IncrementCounter(gomock.Any(), "app_http_service_rejected_count", "path", "http://test.com",
+		"method", http.MethodPost, "reason", "rate_limit")
+
+	resp, err := svc.Get(t.Context(), "test", nil)
+
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	resp, err = svc.Post(t.Context(), "test", nil, nil)
+
+	require.ErrorIs(t, err, ErrRateLimitExceeded)
+	assert.Nil(t, resp)
+}
+
+func TestRateLimiter_WaitsWithinMaxWait(t *testing.T) {
+	config := &RateLimiterConfig{RequestsPerSecond: 50, Burst: 1, MaxWait: time.Second}
+	svc := config.AddOption(&mockHTTP{})
+
+	start := time.Now()
+
+	for range 3 {
+		resp, err := svc.Get(t.Context(), "test", nil)
+
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	assert.GreaterOrEqual(t, time.Since(start), 30*time.Millisecond)
+}
+
+func TestRateLimiter_ContextCancelledWhileWaiting(t *testing.T) {
+	config := &RateLimiterConfig{RequestsPerSecond: 0.1, MaxWait: time.Minute}
+	svc := config.AddOption(&mockHTTP{})
+
+	resp, err := svc.Get(t.Context(), "test", nil)
+
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+
+	resp, err = svc.Delete(ctx, "test", nil)
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, resp)
+}
+
+func TestRateLimiter_NotAppliedWithoutRate(t *testing.T) {
+	downstream := &mockHTTP{}
+	config := &RateLimiterConfig{}
+
+	assert.Equal(t, downstream, config.AddOption(downstream))
+}
+
+func TestNewHTTPService_InstrumentedOptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	metrics := NewMockCounterMetrics(ctrl)
+
+	svc := NewHTTPService("http://test.com", nil, metrics, &RateLimiterConfig{RequestsPerSecond: 1})
+
+	rl, ok := svc.(*rateLimiter)
+
+	require.True(t, ok)
+	assert.Equal(t, "http://test.com", rl.serviceAddress)
+	assert.Equal(t, metrics, rl.metrics)
+}
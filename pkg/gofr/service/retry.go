@@ -5,6 +5,8 @@ import (
 	"net/http"
 )
 
+// RetryConfig retries the requests to a service failing with an error or status 500, up to MaxRetries times.
+// Requests with a streamed body are not retried, as their body cannot be replayed.
 type RetryConfig struct {
 	MaxRetries int
 }
@@ -92,6 +94,21 @@ func (rp *retryProvider) DeleteWithHeaders(ctx context.Context, path string, bod
 	})
 }
 
+func (rp *retryProvider) PostStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody,
+	headers map[string]string) (*http.Response, error) {
+	return sendStream(ctx, rp.HTTP, http.MethodPost, path, queryParams, body, headers)
+}
+
+func (rp *retryProvider) PutStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody,
+	headers map[string]string) (*http.Response, error) {
+	return sendStream(ctx, rp.HTTP, http.MethodPut, path, queryParams, body, headers)
+}
+
+func (rp *retryProvider) PatchStream(ctx context.Context, path string, queryParams map[string]any, body RequestBody,
+	headers map[string]string) (*http.Response, error) {
+	return sendStream(ctx, rp.HTTP, http.MethodPatch, path, queryParams, body, headers)
+}
+
 func (rp *retryProvider) doWithRetry(reqFunc func() (*http.Response, error)) (*http.Response, error) {
 	var (
 		resp *http.Response
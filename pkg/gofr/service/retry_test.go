@@ -63,6 +63,21 @@ func (*mockHTTP) PatchWithHeaders(_ context.Context, _ string, _ map[string]any,
 	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
 }
 
+func (*mockHTTP) PostStream(_ context.Context, _ string, _ map[string]any, _ RequestBody,
+	_ map[string]string) (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusCreated, Body: http.NoBody}, nil
+}
+
+func (*mockHTTP) PutStream(_ context.Context, _ string, _ map[string]any, _ RequestBody,
+	_ map[string]string) (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
+
+func (*mockHTTP) PatchStream(_ context.Context, _ string, _ map[string]any, _ RequestBody,
+	_ map[string]string) (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
+
 func (*mockHTTP) Delete(_ context.Context, _ string, _ []byte) (*http.Response, error) {
 	return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody}, nil
 }
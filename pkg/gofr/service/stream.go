@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strings"
+	"sync"
+)
+
+// RequestBody is a request body streamed to a service from Reader, so that large payloads such as file
+// uploads are not buffered in memory. Response bodies are never buffered by the client and can be
+// streamed by reading from http.Response.Body.
+type RequestBody struct {
+	// Reader is the source of the body. It is closed after the request is sent if it implements io.Closer.
+	Reader io.Reader
+	// ContentLength is the size of the body in bytes. The body is sent with chunked transfer encoding
+	// if it is not positive and cannot be detected from Reader.
+	ContentLength int64
+	// ContentType is the media type of the body. Defaults to application/octet-stream.
+	ContentType string
+}
+
+// ErrStreamingNotSupported is returned when a request body is streamed through a service which does not
+// implement StreamingHTTP.
+var ErrStreamingNotSupported = errors.New("service does not support streamed request bodies")
+
+// sendStream sends a request with the method streaming body through h, which fails if h does not implement
+// StreamingHTTP.
+func sendStream(ctx context.Context, h HTTP, method, path string, queryParams map[string]any, body RequestBody,
+	headers map[string]string) (*http.Response, error) {
+	svc, ok := h.(StreamingHTTP)
+	if !ok {
+		closeBody(body.Reader)
+
+		return nil, ErrStreamingNotSupported
+	}
+
+	switch method {
+	case http.MethodPut:
+		return svc.PutStream(ctx, path, queryParams, body, headers)
+	case http.MethodPatch:
+		return svc.PatchStream(ctx, path, queryParams, body, headers)
+	default:
+		return svc.PostStream(ctx, path, queryParams, body, headers)
+	}
+}
+
+// closeBody closes the request body when the request could not be sent.
+func closeBody(body io.Reader) {
+	if closer, ok := body.(io.Closer); ok {
+		_ = closer.Close()
+	}
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// MultipartForm builds a multipart/form-data request body with fields and files. The files are streamed
+// when the request is sent, so they are never fully buffered in memory.
+type MultipartForm struct {
+	parts []multipartPart
+}
+
+type multipartPart struct {
+	fieldName   string
+	fileName    string
+	contentType string
+	value       string
+	content     io.Reader
+}
+
+// NewMultipartForm returns an empty multipart form.
+func NewMultipartForm() *MultipartForm {
+	return &MultipartForm{}
+}
+
+// AddField adds a form field with the given value.
+func (m *MultipartForm) AddField(name, value string) *MultipartForm {
+	m.parts = append(m.parts, multipartPart{fieldName: name, value: value})
+
+	return m
+}
+
+// AddFile adds a file read from content, sent with the application/octet-stream content type.
+func (m *MultipartForm) AddFile(fieldName, fileName string, content io.Reader) *MultipartForm {
+	return m.AddFileWithContentType(fieldName, fileName, "application/octet-stream", content)
+}
+
+// AddFileWithContentType adds a file read from content, sent with the given content type.
+func (m *MultipartForm) AddFileWithContentType(fieldName, fileName, contentType string, content io.Reader) *MultipartForm {
+	m.parts = append(m.parts, multipartPart{fieldName: fieldName, fileName: fileName, contentType: contentType, content: content})
+
+	return m
+}
+
+// Body returns the request body of the form, to be sent with PostStream, PutStream or PatchStream.
+// The form is encoded while the body is read, so a body can be sent only once.
+func (m *MultipartForm) Body() RequestBody {
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+
+	return RequestBody{
+		Reader:      &multipartReader{form: m, writer: writer, pr: pr, pw: pw},
+		ContentType: writer.FormDataContentType(),
+	}
+}
+
+func (m *MultipartForm) write(writer *multipart.Writer) error {
+	for _, part := range m.parts {
+		if part.content == nil {
+			if err := writer.WriteField(part.fieldName, part.value); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
+			quoteEscaper.Replace(part.fieldName), quoteEscaper.Replace(part.fileName)))
+		header.Set("Content-Type", part.contentType)
+
+		w, err := writer.CreatePart(header)
+		if err != nil {
+			return err
+		}
+
+		if _, err := io.Copy(w, part.content); err != nil {
+			return err
+		}
+	}
+
+	return writer.Close()
+}
+
+// multipartReader encodes the form into a pipe as it is read. The encoding starts on the first read,
+// so that no goroutine is left behind if the request is never sent.
+type multipartReader struct {
+	once   sync.Once
+	form   *MultipartForm
+	writer *multipart.Writer
+	pr     *io.PipeReader
+	pw     *io.PipeWriter
+}
+
+func (r *multipartReader) Read(p []byte) (int, error) {
+	r.once.Do(func() {
+		go func() {
+			r.pw.CloseWithError(r.form.write(r.writer))
+		}()
+	})
+
+	return r.pr.Read(p)
+}
+
+func (r *multipartReader) Close() error {
+	return r.pr.Close()
+}
@@ -0,0 +1,266 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"gofr.dev/pkg/gofr/logging"
+)
+
+// closeTrackingReader records whether it was closed.
+type closeTrackingReader struct {
+	io.Reader
+	closed bool
+}
+
+func (r *closeTrackingReader) Close() error {
+	r.closed = true
+
+	return nil
+}
+
+func TestHTTPService_StreamRequests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	metrics := NewMockMetrics(ctrl)
+
+	tests := []struct {
+		desc   string
+		method string
+		call   func(svc StreamingHTTP, body RequestBody) (*http.Response, error)
+	}{
+		{"post stream", http.MethodPost, func(svc StreamingHTTP, body RequestBody) (*http.Response, error) {
+			return svc.PostStream(t.Context(), "upload", map[string]any{"key": "value"}, body, map[string]string{"header1": "value1"})
+		}},
+		{"put stream", http.MethodPut, func(svc StreamingHTTP, body RequestBody) (*http.Response, error) {
+			return svc.PutStream(t.Context(), "upload", map[string]any{"key": "value"}, body, map[string]string{"header1": "value1"})
+		}},
+		{"patch stream", http.MethodPatch, func(svc StreamingHTTP, body RequestBody) (*http.Response, error) {
+			return svc.PatchStream(t.Context(), "upload", map[string]any{"key": "value"}, body, map[string]string{"header1": "value1"})
+		}},
+	}
+
+	for i, tc := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.method, r.Method)
+			assert.Equal(t, "/upload", r.URL.Path)
+			assert.Equal(t, "key=value", r.URL.RawQuery)
+			assert.Equal(t, "value1", r.Header.Get("Header1"))
+			assert.Equal(t, "text/csv", r.Header.Get("Content-Type"))
+			assert.Equal(t, int64(11), r.ContentLength)
+			assert.Equal(t, "hello,world", string(body))
+
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		svc := NewHTTPService(server.URL, logging.NewMockLogger(logging.INFO), metrics).(StreamingHTTP)
+
+		metrics.EXPECT().RecordHistogram(gomock.Any(), "app_http_service_response", gomock.Any(), "path", server.URL,
+			"method", tc.method, "status", "200")
+
+		// the reader is wrapped so that its size cannot be detected from its type.
+		resp, err := tc.call(svc, RequestBody{
+			Reader:        io.MultiReader(strings.NewReader("hello,world")),
+			ContentLength: 11,
+			ContentType:   "text/csv",
+		})
+
+		require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, http.StatusOK, resp.StatusCode, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+		resp.Body.Close()
+		server.Close()
+	}
+}
+
+func TestHTTPService_StreamRequestDefaults(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		assert.Equal(t, "application/octet-stream", r.Header.Get("Content-Type"))
+		assert.Equal(t, []string{"chunked"}, r.TransferEncoding)
+		assert.Equal(t, "streamed", string(body))
+
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	reader := &closeTrackingReader{Reader: io.MultiReader(strings.NewReader("streamed"))}
+
+	resp, err := newService(t, server).PostStream(t.Context(), "upload", nil, RequestBody{Reader: reader}, nil)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.True(t, reader.closed)
+}
+
+func TestHTTPService_StreamResponse(t *testing.T) {
+	release := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("first"))
+		w.(http.Flusher).Flush()
+
+		// the rest of the body is sent only once the client has received the response.
+		<-release
+
+		_, _ = w.Write([]byte("second"))
+	}))
+	defer server.Close()
+
+	resp, err := newService(t, server).Get(t.Context(), "download", nil)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	close(release)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "firstsecond", string(body))
+}
+
+func TestMultipartForm(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+
+		assert.Equal(t, "gofr", r.FormValue("name"))
+
+		file, header, err := r.FormFile("report")
+		require.NoError(t, err)
+
+		defer file.Close()
+
+		content, err := io.ReadAll(file)
+		require.NoError(t, err)
+
+		assert.Equal(t, `report "2024".csv`, header.Filename)
+		assert.Equal(t, "text/csv", header.Header.Get("Content-Type"))
+		assert.Equal(t, "a,b\n1,2\n", string(content))
+
+		file, header, err = r.FormFile("blob")
+		require.NoError(t, err)
+
+		defer file.Close()
+
+		assert.Equal(t, "application/octet-stream", header.Header.Get("Content-Type"))
+
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	form := NewMultipartForm().
+		AddField("name", "gofr").
+		AddFileWithContentType("report", `report "2024".csv`, "text/csv", strings.NewReader("a,b\n1,2\n")).
+		AddFile("blob", "blob.bin", strings.NewReader("binary"))
+
+	body := form.Body()
+
+	assert.True(t, strings.HasPrefix(body.ContentType, "multipart/form-data; boundary="))
+
+	resp, err := newService(t, server).PostStream(t.Context(), "upload", nil, body, nil)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+}
+
+func TestMultipartForm_ClosedWithoutRead(t *testing.T) {
+	body := NewMultipartForm().AddField("name", "gofr").Body()
+
+	closer, ok := body.Reader.(io.Closer)
+	require.True(t, ok)
+	require.NoError(t, closer.Close())
+
+	_, err := body.Reader.Read(make([]byte, 10))
+	require.ErrorIs(t, err, io.ErrClosedPipe)
+}
+
+func TestStreamRequests_ThroughOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "valid", r.Header.Get(xAPIKeyHeader))
+		assert.Equal(t, "value", r.Header.Get("Custom"))
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	svc, ok := NewHTTPService(server.URL, logging.NewMockLogger(logging.INFO), nil,
+		&APIKeyConfig{APIKey: "valid"},
+		&DefaultHeaders{Headers: map[string]string{"Custom": "value"}},
+		&CircuitBreakerConfig{Threshold: 1, Interval: time.Minute},
+		&BulkheadConfig{MaxConcurrentRequests: 1},
+		&RateLimiterConfig{RequestsPerSecond: 100, Burst: 10},
+		&RetryConfig{MaxRetries: 2},
+		&HedgingConfig{Delay: time.Minute},
+		&CacheConfig{DefaultTTL: time.Minute},
+		&HealthConfig{HealthEndpoint: "health"},
+	).(StreamingHTTP)
+	require.True(t, ok, "the service does not support streamed request bodies")
+
+	ctx := t.Context()
+	calls := []func(body RequestBody) (*http.Response, error){
+		func(body RequestBody) (*http.Response, error) { return svc.PostStream(ctx, "upload", nil, body, nil) },
+		func(body RequestBody) (*http.Response, error) { return svc.PutStream(ctx, "upload", nil, body, nil) },
+		func(body RequestBody) (*http.Response, error) { return svc.PatchStream(ctx, "upload", nil, body, nil) },
+	}
+
+	for i, call := range calls {
+		resp, err := call(RequestBody{Reader: strings.NewReader("data")})
+
+		require.NoError(t, err, "TEST[%d], Failed.\n", i)
+		assert.Equal(t, http.StatusOK, resp.StatusCode, "TEST[%d], Failed.\n", i)
+
+		resp.Body.Close()
+	}
+}
+
+func TestStreamRequests_BodyClosedWhenRejected(t *testing.T) {
+	config := &RateLimiterConfig{RequestsPerSecond: 0.001}
+	svc := config.AddOption(&mockHTTP{}).(StreamingHTTP)
+
+	resp, err := svc.PostStream(t.Context(), "upload", nil, RequestBody{Reader: strings.NewReader("data")}, nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	reader := &closeTrackingReader{Reader: strings.NewReader("data")}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	resp, err = svc.PutStream(ctx, "upload", nil, RequestBody{Reader: reader}, nil)
+
+	require.ErrorIs(t, err, ErrRateLimitExceeded)
+	assert.Nil(t, resp)
+	assert.True(t, reader.closed)
+}
+
+func TestStreamRequests_NotSupported(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	// MockHTTP implements HTTP but not StreamingHTTP.
+	svc := (&DefaultHeaders{}).AddOption(NewMockHTTP(ctrl)).(StreamingHTTP)
+	reader := &closeTrackingReader{Reader: strings.NewReader("data")}
+
+	resp, err := svc.PostStream(t.Context(), "upload", nil, RequestBody{Reader: reader}, nil)
+
+	require.ErrorIs(t, err, ErrStreamingNotSupported)
+	assert.Nil(t, resp)
+	assert.True(t, reader.closed)
+}
@@ -0,0 +1,307 @@
+package service
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr/config"
+)
+
+const defaultKeepAlive = 30 * time.Second
+
+var (
+	errCACertFileRead   = errors.New("failed to read CA certificate file")
+	errCACertParse      = errors.New("failed to parse CA certificate")
+	errClientCertLoad   = errors.New("failed to load client certificate")
+	errInvalidProxyURL  = errors.New("invalid proxy url")
+	errInvalidEnvConfig = errors.New("invalid transport configuration")
+)
+
+// TransportConfig configures the HTTP client and transport used to communicate with a service.
+// Zero values keep the defaults of the net/http package.
+//
+// When the service is registered through AddHTTPService, the values can also be set from environment
+// variables prefixed with HTTP_SERVICE_<SERVICE_NAME>_, e.g. HTTP_SERVICE_PAYMENT_TIMEOUT=5s, which
+// take precedence over the values set in code.
+type TransportConfig struct {
+	// Timeout is the overall time limit for a request, including reading the response body.
+	Timeout time.Duration
+	// DialTimeout is the time limit for establishing a connection.
+	DialTimeout time.Duration
+	// TLSHandshakeTimeout is the time limit for the TLS handshake.
+	TLSHandshakeTimeout time.Duration
+	// ResponseHeaderTimeout is the time limit for reading the response headers after sending the request.
+	ResponseHeaderTimeout time.Duration
+	// IdleConnTimeout is the time after which an idle connection is closed.
+	IdleConnTimeout time.Duration
+
+	// MaxIdleConns is the maximum number of idle connections across all hosts.
+	MaxIdleConns int
+	// MaxIdleConnsPerHost is the maximum number of idle connections kept per host.
+	MaxIdleConnsPerHost int
+	// MaxConnsPerHost is the maximum number of connections per host, including the ones in use.
+	MaxConnsPerHost int
+
+	// ProxyURL is the URL of the HTTP proxy to send the requests through. The proxy is taken from
+	// the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables if not set.
+	ProxyURL string
+
+	// CACertFile is the path of the PEM encoded CA bundle used to verify the certificate of the service.
+	CACertFile string
+	// CertFile and KeyFile are the paths of the PEM encoded client certificate and key for mutual TLS.
+	CertFile string
+	KeyFile  string
+	// InsecureSkipVerify disables the verification of the certificate of the service.
+	InsecureSkipVerify bool
+
+	// DisableHTTP2 restricts the communication with the service to HTTP/1.1.
+	DisableHTTP2 bool
+	// EnableH2C allows HTTP/2 over plain text connections, for services serving HTTP/2 without TLS.
+	EnableH2C bool
+}
+
+// AddOption configures the transport of the service when it is applied directly to the service created by
+// NewHTTPService. NewHTTPService applies it before any other option, irrespective of their order.
+func (t *TransportConfig) AddOption(h HTTP) HTTP {
+	if svc, ok := h.(*httpService); ok {
+		t.configureClient(svc.Client)
+	}
+
+	return h
+}
+
+// configureClient sets up the transport of the client as per the config. If the config is invalid,
+// every request through the client fails with the configuration error.
+func (t *TransportConfig) configureClient(client *http.Client) {
+	transport, err := t.transport()
+	if err != nil {
+		client.Transport = errTransport{err: err}
+
+		return
+	}
+
+	client.Transport = transport
+	client.Timeout = t.Timeout
+}
+
+func (t *TransportConfig) transport() (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if t.DialTimeout > 0 {
+		transport.DialContext = (&net.Dialer{Timeout: t.DialTimeout, KeepAlive: defaultKeepAlive}).DialContext
+	}
+
+	if t.TLSHandshakeTimeout > 0 {
+		transport.TLSHandshakeTimeout = t.TLSHandshakeTimeout
+	}
+
+	if t.IdleConnTimeout > 0 {
+		transport.IdleConnTimeout = t.IdleConnTimeout
+	}
+
+	if t.MaxIdleConns > 0 {
+		transport.MaxIdleConns = t.MaxIdleConns
+	}
+
+	transport.ResponseHeaderTimeout = t.ResponseHeaderTimeout
+	transport.MaxIdleConnsPerHost = t.MaxIdleConnsPerHost
+	transport.MaxConnsPerHost = t.MaxConnsPerHost
+
+	if t.ProxyURL != "" {
+		proxyURL, err := url.Parse(t.ProxyURL)
+		if err != nil || proxyURL.Host == "" {
+			return nil, fmt.Errorf("%w: %q", errInvalidProxyURL, t.ProxyURL)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig, err := t.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	protocols := new(http.Protocols)
+	protocols.SetHTTP1(true)
+	protocols.SetHTTP2(!t.DisableHTTP2)
+	protocols.SetUnencryptedHTTP2(t.EnableH2C)
+
+	transport.Protocols = protocols
+
+	return transport, nil
+}
+
+func (t *TransportConfig) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: t.InsecureSkipVerify, //nolint:gosec // Populate the value as per user input
+	}
+
+	if t.CACertFile != "" {
+		caCert, err := os.ReadFile(t.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errCACertFileRead, err)
+		}
+
+		caCertPool := x509.NewCertPool()
+		if !caCertPool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("%w: %v", errCACertParse, t.CACertFile)
+		}
+
+		tlsConfig.RootCAs = caCertPool
+	}
+
+	if t.CertFile != "" || t.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(t.CertFile, t.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errClientCertLoad, err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// WithEnv returns a copy of the config overridden by the environment variables of the given service.
+// Invalid values are reported in the returned error and do not override the config.
+//
+//	HTTP_SERVICE_<NAME>_TIMEOUT                  overall request timeout, e.g. 10s
+//	HTTP_SERVICE_<NAME>_DIAL_TIMEOUT             connection timeout
+//	HTTP_SERVICE_<NAME>_TLS_HANDSHAKE_TIMEOUT    TLS handshake timeout
+//	HTTP_SERVICE_<NAME>_RESPONSE_HEADER_TIMEOUT  response header timeout
+//	HTTP_SERVICE_<NAME>_IDLE_CONN_TIMEOUT        idle connection timeout
+//	HTTP_SERVICE_<NAME>_MAX_IDLE_CONNS           maximum idle connections
+//	HTTP_SERVICE_<NAME>_MAX_IDLE_CONNS_PER_HOST  maximum idle connections per host
+//	HTTP_SERVICE_<NAME>_MAX_CONNS_PER_HOST       maximum connections per host
+//	HTTP_SERVICE_<NAME>_PROXY_URL                HTTP proxy URL
+//	HTTP_SERVICE_<NAME>_TLS_CA_CERT_FILE         CA bundle path
+//	HTTP_SERVICE_<NAME>_TLS_CERT_FILE            client certificate path
+//	HTTP_SERVICE_<NAME>_TLS_KEY_FILE             client key path
+//	HTTP_SERVICE_<NAME>_TLS_INSECURE_SKIP_VERIFY true to skip the verification of the service certificate
+//	HTTP_SERVICE_<NAME>_HTTP2_ENABLED            false to restrict communication to HTTP/1.1
+//	HTTP_SERVICE_<NAME>_H2C_ENABLED              true to allow HTTP/2 over plain text connections
+//
+// <NAME> is the service name in upper case with characters other than letters and digits replaced by '_'.
+func (t *TransportConfig) WithEnv(conf config.Config, serviceName string) (*TransportConfig, error) {
+	env := transportEnv{conf: conf, prefix: envPrefix(serviceName)}
+	result := *t
+
+	env.duration("TIMEOUT", &result.Timeout)
+	env.duration("DIAL_TIMEOUT", &result.DialTimeout)
+	env.duration("TLS_HANDSHAKE_TIMEOUT", &result.TLSHandshakeTimeout)
+	env.duration("RESPONSE_HEADER_TIMEOUT", &result.ResponseHeaderTimeout)
+	env.duration("IDLE_CONN_TIMEOUT", &result.IdleConnTimeout)
+	env.int("MAX_IDLE_CONNS", &result.MaxIdleConns)
+	env.int("MAX_IDLE_CONNS_PER_HOST", &result.MaxIdleConnsPerHost)
+	env.int("MAX_CONNS_PER_HOST", &result.MaxConnsPerHost)
+	env.string("PROXY_URL", &result.ProxyURL)
+	env.string("TLS_CA_CERT_FILE", &result.CACertFile)
+	env.string("TLS_CERT_FILE", &result.CertFile)
+	env.string("TLS_KEY_FILE", &result.KeyFile)
+	env.bool("TLS_INSECURE_SKIP_VERIFY", &result.InsecureSkipVerify)
+
+	var http2Enabled = !result.DisableHTTP2
+
+	env.bool("HTTP2_ENABLED", &http2Enabled)
+	result.DisableHTTP2 = !http2Enabled
+
+	env.bool("H2C_ENABLED", &result.EnableH2C)
+
+	return &result, errors.Join(env.errs...)
+}
+
+func envPrefix(serviceName string) string {
+	name := strings.Map(func(r rune) rune {
+		if ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9') {
+			return r
+		}
+
+		return '_'
+	}, serviceName)
+
+	return "HTTP_SERVICE_" + strings.ToUpper(name) + "_"
+}
+
+// transportEnv reads the transport configuration of a service from the environment.
+type transportEnv struct {
+	conf   config.Config
+	prefix string
+	errs   []error
+}
+
+func (e *transportEnv) lookup(key string) (string, bool) {
+	value := e.conf.Get(e.prefix + key)
+
+	return value, value != ""
+}
+
+func (e *transportEnv) parse(key string, parse func(value string) error) {
+	value, ok := e.lookup(key)
+	if !ok {
+		return
+	}
+
+	if err := parse(value); err != nil {
+		e.errs = append(e.errs, fmt.Errorf("%w: %v%v=%q", errInvalidEnvConfig, e.prefix, key, value))
+	}
+}
+
+func (e *transportEnv) duration(key string, target *time.Duration) {
+	e.parse(key, func(value string) error {
+		d, err := time.ParseDuration(value)
+		if err == nil {
+			*target = d
+		}
+
+		return err
+	})
+}
+
+func (e *transportEnv) int(key string, target *int) {
+	e.parse(key, func(value string) error {
+		i, err := strconv.Atoi(value)
+		if err == nil {
+			*target = i
+		}
+
+		return err
+	})
+}
+
+func (e *transportEnv) bool(key string, target *bool) {
+	e.parse(key, func(value string) error {
+		b, err := strconv.ParseBool(value)
+		if err == nil {
+			*target = b
+		}
+
+		return err
+	})
+}
+
+func (e *transportEnv) string(key string, target *string) {
+	if value, ok := e.lookup(key); ok {
+		*target = value
+	}
+}
+
+// errTransport fails every request with the error in the transport configuration of the service.
+type errTransport struct {
+	err error
+}
+
+func (e errTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, e.err
+}
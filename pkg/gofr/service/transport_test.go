@@ -0,0 +1,197 @@
+package service
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gofr.dev/pkg/gofr/config"
+	"gofr.dev/pkg/gofr/logging"
+)
+
+func TestTransportConfig_Transport(t *testing.T) {
+	config := &TransportConfig{
+		DialTimeout:           time.Second,
+		TLSHandshakeTimeout:   2 * time.Second,
+		ResponseHeaderTimeout: 3 * time.Second,
+		IdleConnTimeout:       4 * time.Second,
+		MaxIdleConns:          10,
+		MaxIdleConnsPerHost:   5,
+		MaxConnsPerHost:       20,
+		ProxyURL:              "http://proxy.local:3128",
+		DisableHTTP2:          true,
+		EnableH2C:             true,
+	}
+
+	transport, err := config.transport()
+	require.NoError(t, err)
+
+	assert.NotNil(t, transport.DialContext)
+	assert.Equal(t, 2*time.Second, transport.TLSHandshakeTimeout)
+	assert.Equal(t, 3*time.Second, transport.ResponseHeaderTimeout)
+	assert.Equal(t, 4*time.Second, transport.IdleConnTimeout)
+	assert.Equal(t, 10, transport.MaxIdleConns)
+	assert.Equal(t, 5, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, 20, transport.MaxConnsPerHost)
+	assert.False(t, transport.Protocols.HTTP2())
+	assert.True(t, transport.Protocols.UnencryptedHTTP2())
+
+	proxy, err := transport.Proxy(httptest.NewRequest(http.MethodGet, "http://example.com", http.NoBody))
+	require.NoError(t, err)
+	assert.Equal(t, "proxy.local:3128", proxy.Host)
+}
+
+func TestTransportConfig_InvalidConfig(t *testing.T) {
+	tests := []struct {
+		desc   string
+		config *TransportConfig
+		err    error
+	}{
+		{"invalid proxy url", &TransportConfig{ProxyURL: "://proxy"}, errInvalidProxyURL},
+		{"missing CA file", &TransportConfig{CACertFile: "/does/not/exist.pem"}, errCACertFileRead},
+		{"missing client cert", &TransportConfig{CertFile: "/does/not/exist.pem"}, errClientCertLoad},
+	}
+
+	for i, tc := range tests {
+		svc := NewHTTPService("http://localhost", logging.NewMockLogger(logging.ERROR), nil, tc.config)
+
+		resp, err := svc.Get(t.Context(), "test", nil)
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		require.ErrorIs(t, err, tc.err, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestTransportConfig_Timeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	svc := NewHTTPService(server.URL, logging.NewMockLogger(logging.ERROR), nil,
+		&TransportConfig{Timeout: 50 * time.Millisecond})
+
+	resp, err := svc.Get(t.Context(), "test", nil)
+	if resp != nil {
+		resp.Body.Close()
+	}
+
+	require.ErrorContains(t, err, "Client.Timeout exceeded")
+}
+
+func TestTransportConfig_MutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeClientCertificate(t, dir)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert, MinVersion: tls.VersionTLS12}
+	server.StartTLS()
+
+	defer server.Close()
+
+	caFile := filepath.Join(dir, "ca.pem")
+	require.NoError(t, os.WriteFile(caFile,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0o600))
+
+	svc := NewHTTPService(server.URL, logging.NewMockLogger(logging.ERROR), nil,
+		&TransportConfig{CACertFile: caFile, CertFile: certFile, KeyFile: keyFile})
+
+	resp, err := svc.Get(t.Context(), "test", nil)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// without the CA bundle, the certificate of the server is not trusted.
+	svc = NewHTTPService(server.URL, logging.NewMockLogger(logging.ERROR), nil,
+		&TransportConfig{CertFile: certFile, KeyFile: keyFile})
+
+	resp, err = svc.Get(t.Context(), "test", nil)
+	if resp != nil {
+		resp.Body.Close()
+	}
+
+	require.ErrorContains(t, err, "certificate")
+}
+
+func TestTransportConfig_WithEnv(t *testing.T) {
+	conf := config.NewMockConfig(map[string]string{
+		"HTTP_SERVICE_CAT_FACTS_TIMEOUT":            "5s",
+		"HTTP_SERVICE_CAT_FACTS_MAX_CONNS_PER_HOST": "50",
+		"HTTP_SERVICE_CAT_FACTS_PROXY_URL":          "http://proxy.local",
+		"HTTP_SERVICE_CAT_FACTS_HTTP2_ENABLED":      "false",
+		"HTTP_SERVICE_CAT_FACTS_DIAL_TIMEOUT":       "five seconds",
+		"HTTP_SERVICE_OTHER_TIMEOUT":                "1s",
+	})
+
+	base := &TransportConfig{Timeout: time.Second, DialTimeout: 2 * time.Second, MaxIdleConns: 10}
+
+	result, err := base.WithEnv(conf, "cat-facts")
+
+	require.ErrorIs(t, err, errInvalidEnvConfig)
+	assert.Contains(t, err.Error(), "HTTP_SERVICE_CAT_FACTS_DIAL_TIMEOUT")
+	assert.Equal(t, &TransportConfig{
+		Timeout:         5 * time.Second,
+		DialTimeout:     2 * time.Second,
+		MaxIdleConns:    10,
+		MaxConnsPerHost: 50,
+		ProxyURL:        "http://proxy.local",
+		DisableHTTP2:    true,
+	}, result)
+	assert.Equal(t, time.Second, base.Timeout, "original config must not be modified")
+}
+
+func writeClientCertificate(t *testing.T, dir string) (certFile, keyFile string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "gofr-client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile = filepath.Join(dir, "client.pem")
+	keyFile = filepath.Join(dir, "client-key.pem")
+
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	return certFile, keyFile
+}
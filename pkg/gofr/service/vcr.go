@@ -0,0 +1,366 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// ErrNoRecordedInteraction is returned in strict replay mode when no recorded interaction matches a request.
+var ErrNoRecordedInteraction = errors.New("no recorded interaction matches the request")
+
+var (
+	errCassetteRead  = errors.New("failed to read cassette")
+	errCassetteWrite = errors.New("failed to write cassette")
+)
+
+const redactedHeaderValue = "[REDACTED]"
+
+// VCRMode is the mode in which a VCRConfig serves the requests to a service.
+type VCRMode int
+
+const (
+	// VCRModeReplay serves the requests from the interactions recorded in the cassette.
+	VCRModeReplay VCRMode = iota
+	// VCRModeRecord sends the requests to the service and records the interactions in the cassette,
+	// replacing the interactions recorded before.
+	VCRModeRecord
+)
+
+// VCRConfig records the requests to a service and their responses to a cassette file, and replays them
+// in tests without the service being available. As the interactions are recorded at the transport level,
+// the recorded requests include the headers added by the other options, such as authentication.
+type VCRConfig struct {
+	// Cassette is the path of the JSON file the interactions are recorded to and replayed from.
+	Cassette string
+	// Mode is VCRModeReplay by default.
+	Mode VCRMode
+	// Matchers decide which recorded interaction is replayed for a request.
+	// Defaults to MatchMethod, MatchPath and MatchQuery.
+	Matchers []VCRMatcher
+	// RedactHeaders are the request and response headers whose values are not written to the cassette,
+	// in addition to Authorization, Cookie, Set-Cookie and X-Api-Key.
+	RedactHeaders []string
+	// Strict fails the requests matching no recorded interaction with ErrNoRecordedInteraction in replay mode.
+	// Otherwise, such requests are sent to the service and their interactions are added to the cassette.
+	Strict bool
+}
+
+// VCRRequest is a request recorded in a cassette.
+type VCRRequest struct {
+	Method  string      `json:"method"`
+	Path    string      `json:"path"`
+	Query   string      `json:"query,omitempty"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+// VCRResponse is a response recorded in a cassette.
+type VCRResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// VCRInteraction is a request and its response recorded in a cassette.
+type VCRInteraction struct {
+	Request  VCRRequest  `json:"request"`
+	Response VCRResponse `json:"response"`
+}
+
+type cassette struct {
+	Interactions []*VCRInteraction `json:"interactions"`
+}
+
+// VCRMatcher reports whether a request matches a recorded one.
+type VCRMatcher func(req, recorded *VCRRequest) bool
+
+// MatchMethod matches the requests with the same method.
+func MatchMethod(req, recorded *VCRRequest) bool {
+	return req.Method == recorded.Method
+}
+
+// MatchPath matches the requests with the same path.
+func MatchPath(req, recorded *VCRRequest) bool {
+	return req.Path == recorded.Path
+}
+
+// MatchQuery matches the requests with the same query parameters, irrespective of their order.
+func MatchQuery(req, recorded *VCRRequest) bool {
+	reqQuery, err := url.ParseQuery(req.Query)
+	if err != nil {
+		return req.Query == recorded.Query
+	}
+
+	recordedQuery, err := url.ParseQuery(recorded.Query)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(reqQuery, recordedQuery)
+}
+
+// MatchBody matches the requests with the same body. JSON bodies match irrespective of formatting and key order.
+func MatchBody(req, recorded *VCRRequest) bool {
+	if req.Body == recorded.Body {
+		return true
+	}
+
+	var reqBody, recordedBody any
+
+	if json.Unmarshal([]byte(req.Body), &reqBody) != nil || json.Unmarshal([]byte(recorded.Body), &recordedBody) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(reqBody, recordedBody)
+}
+
+// AddOption records or replays the interactions of the service when it is applied directly to the service
+// created by NewHTTPService. NewHTTPService applies it before the options wrapping the service.
+func (v *VCRConfig) AddOption(h HTTP) HTTP {
+	if svc, ok := h.(*httpService); ok {
+		v.configureClient(svc.Client)
+	}
+
+	return h
+}
+
+func (v *VCRConfig) configureClient(client *http.Client) {
+	next := client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	recorder := &vcrTransport{config: v, next: next, used: make(map[int]bool)}
+
+	if v.Mode == VCRModeReplay {
+		if err := recorder.load(); err != nil {
+			client.Transport = errTransport{err: err}
+
+			return
+		}
+	}
+
+	matchers := v.Matchers
+	if len(matchers) == 0 {
+		matchers = []VCRMatcher{MatchMethod, MatchPath, MatchQuery}
+	}
+
+	redacted := []string{AuthHeader, "Cookie", "Set-Cookie", xAPIKeyHeader}
+	for _, h := range v.RedactHeaders {
+		redacted = append(redacted, http.CanonicalHeaderKey(h))
+	}
+
+	recorder.matchers = matchers
+	recorder.redacted = redacted
+	client.Transport = recorder
+}
+
+type vcrTransport struct {
+	config   *VCRConfig
+	matchers []VCRMatcher
+	redacted []string
+	next     http.RoundTripper
+
+	mu       sync.Mutex
+	cassette cassette
+	used     map[int]bool
+}
+
+// load reads the interactions recorded in the cassette. A missing cassette has no interactions.
+func (t *vcrTransport) load() error {
+	data, err := os.ReadFile(t.config.Cassette)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w %v: %w", errCassetteRead, t.config.Cassette, err)
+	}
+
+	if err := json.Unmarshal(data, &t.cassette); err != nil {
+		return fmt.Errorf("%w %v: %w", errCassetteRead, t.config.Cassette, err)
+	}
+
+	return nil
+}
+
+func (t *vcrTransport) save() error {
+	data, err := json.MarshalIndent(&t.cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("%w %v: %w", errCassetteWrite, t.config.Cassette, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(t.config.Cassette), 0o755); err != nil {
+		return fmt.Errorf("%w %v: %w", errCassetteWrite, t.config.Cassette, err)
+	}
+
+	if err := os.WriteFile(t.config.Cassette, data, 0o600); err != nil {
+		return fmt.Errorf("%w %v: %w", errCassetteWrite, t.config.Cassette, err)
+	}
+
+	return nil
+}
+
+func (t *vcrTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	recordedReq, err := t.recordRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.config.Mode == VCRModeReplay {
+		if interaction := t.match(recordedReq); interaction != nil {
+			return replay(req, interaction), nil
+		}
+
+		if t.config.Strict {
+			return nil, fmt.Errorf("%w: %v %v", ErrNoRecordedInteraction, req.Method, req.URL.RequestURI())
+		}
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	interaction := &VCRInteraction{
+		Request: *recordedReq,
+		Response: VCRResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    t.redact(resp.Header),
+			Body:       string(body),
+		},
+	}
+	interaction.Request.Headers = t.redact(req.Header)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.cassette.Interactions = append(t.cassette.Interactions, interaction)
+	t.used[len(t.cassette.Interactions)-1] = true
+
+	if err := t.save(); err != nil {
+		resp.Body.Close()
+
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// recordRequest returns the request as recorded in a cassette, buffering its body so that it can still be sent.
+func (*vcrTransport) recordRequest(req *http.Request) (*VCRRequest, error) {
+	recorded := &VCRRequest{
+		Method:  req.Method,
+		Path:    req.URL.Path,
+		Query:   req.URL.RawQuery,
+		Headers: req.Header,
+	}
+
+	if req.Body == nil || req.Body == http.NoBody {
+		return recorded, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	recorded.Body = string(body)
+
+	return recorded, nil
+}
+
+// match returns the first matching interaction which is not replayed yet, or else the last matching one,
+// so that the same request can be replayed multiple times.
+func (t *vcrTransport) match(req *VCRRequest) *VCRInteraction {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	lastMatch := -1
+
+	for i, interaction := range t.cassette.Interactions {
+		if !t.matches(req, &interaction.Request) {
+			continue
+		}
+
+		if !t.used[i] {
+			t.used[i] = true
+
+			return interaction
+		}
+
+		lastMatch = i
+	}
+
+	if lastMatch < 0 {
+		return nil
+	}
+
+	return t.cassette.Interactions[lastMatch]
+}
+
+func (t *vcrTransport) matches(req, recorded *VCRRequest) bool {
+	for _, matcher := range t.matchers {
+		if !matcher(req, recorded) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (t *vcrTransport) redact(headers http.Header) http.Header {
+	redacted := headers.Clone()
+
+	for _, h := range t.redacted {
+		if _, ok := redacted[h]; ok {
+			redacted[h] = []string{redactedHeaderValue}
+		}
+	}
+
+	return redacted
+}
+
+func replay(req *http.Request, interaction *VCRInteraction) *http.Response {
+	headers := interaction.Response.Headers.Clone()
+	if headers == nil {
+		headers = make(http.Header)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        headers,
+		Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+		ContentLength: int64(len(interaction.Response.Body)),
+		Request:       req,
+	}
+}
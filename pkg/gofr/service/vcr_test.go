@@ -0,0 +1,152 @@
+package service
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gofr.dev/pkg/gofr/logging"
+)
+
+func newVCRTestServer(t *testing.T, calls *int) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*calls++
+
+		body, _ := io.ReadAll(r.Body)
+
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(r.URL.Path + " " + r.URL.RawQuery + " " + string(body)))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func readResponse(t *testing.T, resp *http.Response, err error) string {
+	t.Helper()
+
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	return string(body)
+}
+
+func TestVCR_RecordAndReplay(t *testing.T) {
+	var calls int
+
+	server := newVCRTestServer(t, &calls)
+	cassettePath := filepath.Join(t.TempDir(), "fixtures", "users.json")
+
+	recorder := NewHTTPService(server.URL, logging.NewMockLogger(logging.ERROR), nil,
+		&VCRConfig{Cassette: cassettePath, Mode: VCRModeRecord}, &APIKeyConfig{APIKey: "api-key"})
+
+	resp, err := recorder.Post(t.Context(), "users", map[string]any{"a": 1, "b": 2}, []byte(`{"name":"gofr"}`))
+	assert.Equal(t, `/users a=1&b=2 {"name":"gofr"}`, readResponse(t, resp, err))
+
+	server.Close()
+
+	replayer := NewHTTPService("http://unreachable.invalid", logging.NewMockLogger(logging.ERROR), nil,
+		&VCRConfig{Cassette: cassettePath, Matchers: []VCRMatcher{MatchMethod, MatchPath, MatchQuery, MatchBody},
+			Strict: true})
+
+	resp, err = replayer.Post(t.Context(), "users", map[string]any{"b": 2, "a": 1}, []byte(`{ "name": "gofr" }`))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, `/users a=1&b=2 {"name":"gofr"}`, readResponse(t, resp, err))
+
+	_, err = replayer.Post(t.Context(), "users", map[string]any{"a": 1, "b": 2}, []byte(`{"name":"other"}`))
+	require.ErrorIs(t, err, ErrNoRecordedInteraction)
+
+	assert.Equal(t, 1, calls)
+}
+
+func TestVCR_RedactsHeaders(t *testing.T) {
+	var calls int
+
+	server := newVCRTestServer(t, &calls)
+	cassettePath := filepath.Join(t.TempDir(), "cassette.json")
+
+	svc := NewHTTPService(server.URL, logging.NewMockLogger(logging.ERROR), nil,
+		&VCRConfig{Cassette: cassettePath, Mode: VCRModeRecord, RedactHeaders: []string{"x-tenant"}},
+		&APIKeyConfig{APIKey: "api-key"})
+
+	resp, err := svc.GetWithHeaders(t.Context(), "users", nil, map[string]string{"X-Tenant": "tenant-1"})
+	readResponse(t, resp, err)
+
+	data, err := os.ReadFile(cassettePath)
+	require.NoError(t, err)
+
+	var recorded cassette
+
+	require.NoError(t, json.Unmarshal(data, &recorded))
+	require.Len(t, recorded.Interactions, 1)
+
+	interaction := recorded.Interactions[0]
+
+	assert.Equal(t, redactedHeaderValue, interaction.Request.Headers.Get(xAPIKeyHeader))
+	assert.Equal(t, redactedHeaderValue, interaction.Request.Headers.Get("X-Tenant"))
+	assert.Equal(t, redactedHeaderValue, interaction.Response.Headers.Get("Set-Cookie"))
+	assert.NotContains(t, string(data), "api-key")
+	assert.NotContains(t, string(data), "session=secret")
+}
+
+func TestVCR_ReplaysInteractionsInOrder(t *testing.T) {
+	cassettePath := filepath.Join(t.TempDir(), "cassette.json")
+
+	data, err := json.Marshal(cassette{Interactions: []*VCRInteraction{
+		{Request: VCRRequest{Method: http.MethodGet, Path: "/status"}, Response: VCRResponse{StatusCode: http.StatusOK, Body: "pending"}},
+		{Request: VCRRequest{Method: http.MethodGet, Path: "/status"}, Response: VCRResponse{StatusCode: http.StatusOK, Body: "done"}},
+	}})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(cassettePath, data, 0o600))
+
+	svc := NewHTTPService("http://unreachable.invalid", logging.NewMockLogger(logging.ERROR), nil,
+		&VCRConfig{Cassette: cassettePath, Strict: true})
+
+	for _, expected := range []string{"pending", "done", "done"} {
+		resp, err := svc.Get(t.Context(), "status", nil)
+		assert.Equal(t, expected, readResponse(t, resp, err))
+	}
+}
+
+func TestVCR_NonStrictReplayRecordsUnmatchedRequests(t *testing.T) {
+	var calls int
+
+	server := newVCRTestServer(t, &calls)
+	cassettePath := filepath.Join(t.TempDir(), "cassette.json")
+
+	for range 2 {
+		svc := NewHTTPService(server.URL, logging.NewMockLogger(logging.ERROR), nil, &VCRConfig{Cassette: cassettePath})
+
+		resp, err := svc.Get(t.Context(), "users", nil)
+		assert.Equal(t, "/users  ", readResponse(t, resp, err))
+	}
+
+	assert.Equal(t, 1, calls)
+}
+
+func TestVCR_InvalidCassette(t *testing.T) {
+	cassettePath := filepath.Join(t.TempDir(), "cassette.json")
+	require.NoError(t, os.WriteFile(cassettePath, []byte("not json"), 0o600))
+
+	svc := NewHTTPService("http://unreachable.invalid", logging.NewMockLogger(logging.ERROR), nil,
+		&VCRConfig{Cassette: cassettePath})
+
+	_, err := svc.Get(t.Context(), "users", nil) //nolint:bodyclose // no response is returned on error
+
+	require.ErrorIs(t, err, errCassetteRead)
+}
@@ -2,29 +2,82 @@ package gofr
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"runtime/debug"
 	"time"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
 	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/datasource/pubsub"
 	"gofr.dev/pkg/gofr/logging"
+	"gofr.dev/pkg/gofr/version"
 )
 
+var errHandlerPanic = errors.New("handler panicked")
+
 type SubscribeFunc func(c *Context) error
 
+// SubscribeOption configures a subscription registered with App.Subscribe.
+type SubscribeOption func(s *subscription)
+
+// subscription is the handler of a topic along with its options.
+type subscription struct {
+	handler     SubscribeFunc
+	batch       *batchSubscription
+	retry       *RetryPolicy
+	concurrency int
+	codec       pubsub.Codec
+	dedup       *Deduplication
+	status      *subscriptionStatus
+	commits     *commitTracker
+}
+
+// WithCodec sets the codec with which the messages of the subscription are decoded by Bind, e.g. a schema-aware
+// codec of the schema package.
+func WithCodec(codec pubsub.Codec) SubscribeOption {
+	return func(s *subscription) {
+		s.codec = codec
+	}
+}
+
 type SubscriptionManager struct {
 	container     *container.Container
-	subscriptions map[string]SubscribeFunc
+	subscriptions map[string]*subscription
 }
 
 func newSubscriptionManager(c *container.Container) SubscriptionManager {
 	return SubscriptionManager{
 		container:     c,
-		subscriptions: make(map[string]SubscribeFunc),
+		subscriptions: make(map[string]*subscription),
 	}
 }
 
+func newSubscription(handler SubscribeFunc, options ...SubscribeOption) *subscription {
+	s := &subscription{handler: handler, status: &subscriptionStatus{}, commits: newCommitTracker()}
+
+	for _, option := range options {
+		option(s)
+	}
+
+	return s
+}
+
 // startSubscriber continuously subscribes to a topic and handles messages using the provided handler.
-func (s *SubscriptionManager) startSubscriber(ctx context.Context, topic string, handler SubscribeFunc) error {
+func (s *SubscriptionManager) startSubscriber(ctx context.Context, topic string, sub *subscription) error {
+	sub.status.setRunning(true)
+	defer sub.status.setRunning(false)
+
+	if sub.batch != nil {
+		return s.startBatchSubscriber(ctx, topic, sub)
+	}
+
+	if sub.concurrency > 1 {
+		return s.startConcurrentSubscriber(ctx, topic, sub)
+	}
+
 	var delay time.Duration
 
 	for {
@@ -33,7 +86,7 @@ func (s *SubscriptionManager) startSubscriber(ctx context.Context, topic string,
 			s.container.Logger.Infof("shutting down subscriber for topic %s", topic)
 			return nil
 		case <-time.After(delay):
-			err := s.handleSubscription(ctx, topic, handler)
+			err := s.handleSubscription(ctx, topic, sub)
 			if err != nil {
 				s.container.Logger.Errorf("error in subscription for topic %s: %v", topic, err)
 
@@ -43,8 +96,10 @@ func (s *SubscriptionManager) startSubscriber(ctx context.Context, topic string,
 	}
 }
 
-func (s *SubscriptionManager) handleSubscription(ctx context.Context, topic string, handler SubscribeFunc) error {
+func (s *SubscriptionManager) handleSubscription(ctx context.Context, topic string, sub *subscription) error {
 	msg, err := s.container.GetSubscriber().Subscribe(ctx, topic)
+	sub.status.read(err)
+
 	if err != nil {
 		s.container.Logger.Errorf("error while reading from topic %v, err: %v", topic, err.Error())
 
@@ -55,29 +110,97 @@ func (s *SubscriptionManager) handleSubscription(ctx context.Context, topic stri
 		return nil
 	}
 
-	// newContext creates a new context from the msg.Context()
-	msgCtx := newContext(nil, msg, s.container)
+	if s.processMessage(ctx, topic, msg, sub) && msg.Committer != nil {
+		// commit the message if the subscription function does not return error
+		msg.Commit()
+	} else {
+		reject(msg)
+	}
 
-	err = func(ctx *Context) error {
-		// TODO : Move panic recovery at central location which will manage for all the different cases.
-		defer func() {
-			panicRecovery(recover(), ctx.Logger)
-		}()
+	return nil
+}
+
+// setCodec sets the codec of the subscription on the message, when it is set.
+func (sub *subscription) setCodec(msg *pubsub.Message) {
+	if sub.codec != nil {
+		msg.SetCodec(sub.codec)
+	}
+}
 
-		return handler(ctx)
-	}(msgCtx)
+// reject rejects the message which is not committed, when its broker holds the messages until they are acknowledged.
+func reject(msg *pubsub.Message) {
+	if r, ok := msg.Committer.(pubsub.Rejecter); ok {
+		r.Reject()
+	}
+}
+
+// processMessage handles the message, reporting whether it is to be committed.
+func (s *SubscriptionManager) processMessage(ctx context.Context, topic string, msg *pubsub.Message, sub *subscription) bool {
+	// the span of the handler continues the trace of the publisher, when its trace context is in the headers.
+	spanCtx, span := otel.GetTracerProvider().Tracer("gofr-"+version.Framework).Start(
+		pubsub.ExtractTraceContext(msg.Context(), msg.Headers()), "process "+topic, trace.WithSpanKind(trace.SpanKindConsumer))
+	defer span.End()
+
+	msg.SetContext(spanCtx)
+	sub.setCodec(msg)
+
+	if s.isDuplicate(ctx, topic, msg, sub) {
+		return true
+	}
+
+	start := s.startProcessing(ctx, topic, sub, 1)
+
+	err := s.handleMessage(ctx, topic, msg, sub)
 	if err != nil {
+		s.endProcessing(ctx, topic, sub, 1, start, err)
+
 		s.container.Logger.Errorf("error in handler for topic %s: %v", topic, err)
 
-		return nil
+		// the message is committed only once it is published to the dead-letter topic.
+		return s.deadLetter(topic, msg, sub.retry, err)
 	}
 
-	if msg.Committer != nil {
-		// commit the message if the subscription function does not return error
-		msg.Commit()
+	s.endProcessing(ctx, topic, sub, 1, start)
+	s.markProcessed(topic, msg, sub)
+
+	return true
+}
+
+// handleMessage calls the handler with the message, retrying it as per the retry policy of the subscription.
+func (s *SubscriptionManager) handleMessage(ctx context.Context, topic string, msg *pubsub.Message, sub *subscription) error {
+	attempts := sub.retry.attempts()
+
+	for attempt := 1; ; attempt++ {
+		// newContext creates a new context from the msg.Context()
+		err := callHandler(newContext(nil, msg, s.container), sub.handler)
+		if err == nil || attempt >= attempts {
+			return err
+		}
+
+		s.container.Logger.Errorf("error in handler for topic %s, attempt %d of %d: %v", topic, attempt, attempts, err)
+		s.container.Metrics().IncrementCounter(ctx, "app_pubsub_subscribe_retry_count", "topic", topic)
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(sub.retry.backoff(attempt)):
+		}
 	}
+}
 
-	return nil
+// callHandler calls the handler with the message, returning an error when the handler panics, so that the message is
+// retried or dead-lettered as per the retry policy, and not committed.
+func callHandler(ctx *Context, handler SubscribeFunc) (err error) {
+	// TODO : Move panic recovery at central location which will manage for all the different cases.
+	defer func() {
+		if re := recover(); re != nil {
+			panicRecovery(re, ctx.Logger)
+
+			err = fmt.Errorf("%w: %v", errHandlerPanic, re)
+		}
+	}()
+
+	return handler(ctx)
 }
 
 type panicLog struct {
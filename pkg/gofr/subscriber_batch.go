@@ -0,0 +1,366 @@
+package gofr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+	"gofr.dev/pkg/gofr/version"
+)
+
+var (
+	errNotSlicePointer   = errors.New("input should be a pointer to a slice")
+	errBatchHandlerPanic = errors.New("batch handler panicked")
+)
+
+// BatchSubscribeFunc handles a batch of messages of a topic. It returns a *BatchError when only some of the messages
+// fail, and any other error when the whole batch fails.
+type BatchSubscribeFunc func(c *Context, messages []*pubsub.Message) error
+
+// BatchError reports the messages of a batch for which the BatchSubscribeFunc failed, so that only these messages
+// are retried or dead-lettered, while the others are committed. For the brokers, e.g. Kafka, in which committing
+// a message commits the earlier messages of its partition, a failed message which is not dead-lettered stops the
+// commits of its partition till the subscriber restarts, so that it is read again along with the later messages
+// of the partition once the app restarts or the partition is assigned to another consumer.
+type BatchError struct {
+	// Failed maps the index of each failed message in the batch to its error.
+	Failed map[int]error
+}
+
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("%d messages of the batch failed", len(e.Failed))
+}
+
+// batchSubscription is the handler of the batches of a subscription registered by App.SubscribeBatch.
+type batchSubscription struct {
+	handler BatchSubscribeFunc
+	maxSize int
+	maxWait time.Duration
+}
+
+// startBatchSubscriber reads the messages of the topic in batches of at most maxSize messages, each handled
+// once it is full or maxWait after its first message is read, until ctx is done. The batch being read when ctx
+// is done is not handled, so its messages are not committed.
+func (s *SubscriptionManager) startBatchSubscriber(ctx context.Context, topic string, sub *subscription) error {
+	// the messages are read with ctx, as some of the clients, e.g. Google, receive the messages with the context
+	// of the first read. Only one message is read ahead while a batch is handled.
+	messages := make(chan *pubsub.Message)
+
+	go s.readMessages(ctx, topic, sub, messages)
+
+	for {
+		var batch []*pubsub.Message
+
+		select {
+		case <-ctx.Done():
+			s.container.Logger.Infof("shutting down subscriber for topic %s", topic)
+			return nil
+		case msg := <-messages:
+			batch = append(batch, msg)
+		}
+
+		timer := time.NewTimer(sub.batch.maxWait)
+
+	collect:
+		for len(batch) < sub.batch.maxSize {
+			select {
+			case msg := <-messages:
+				batch = append(batch, msg)
+			case <-timer.C:
+				break collect
+			case <-ctx.Done():
+				timer.Stop()
+				s.container.Logger.Infof("shutting down subscriber for topic %s", topic)
+
+				return nil
+			}
+		}
+
+		timer.Stop()
+
+		s.handleBatch(ctx, topic, batch, sub)
+	}
+}
+
+// readMessages reads the messages of the topic into messages, until ctx is done.
+func (s *SubscriptionManager) readMessages(ctx context.Context, topic string, sub *subscription,
+	messages chan<- *pubsub.Message) {
+	for {
+		msg, err := s.container.GetSubscriber().Subscribe(ctx, topic)
+		sub.status.read(err)
+
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err != nil:
+			s.container.Logger.Errorf("error while reading from topic %v, err: %v", topic, err.Error())
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Second * 2):
+			}
+		case msg != nil:
+			select {
+			case <-ctx.Done():
+				return
+			case messages <- msg:
+			}
+		}
+	}
+}
+
+// handleBatch calls the handler with the batch, retrying the failed messages as per the retry policy of the
+// subscription, and commits the messages which are handled or dead-lettered.
+func (s *SubscriptionManager) handleBatch(ctx context.Context, topic string, batch []*pubsub.Message, sub *subscription) {
+	links := make([]trace.Link, 0, len(batch))
+
+	for _, msg := range batch {
+		links = append(links, trace.LinkFromContext(pubsub.ExtractTraceContext(msg.Context(), msg.Headers())))
+
+		sub.setCodec(msg)
+	}
+
+	// the span of the batch is linked to the spans of the publishers of its messages.
+	spanCtx, span := otel.GetTracerProvider().Tracer("gofr-"+version.Framework).Start(ctx, "process-batch "+topic,
+		trace.WithSpanKind(trace.SpanKindConsumer), trace.WithLinks(links...))
+	defer span.End()
+
+	// the duplicate messages are committed without being passed to the handler.
+	messages := make([]*pubsub.Message, 0, len(batch))
+
+	for _, msg := range batch {
+		if !s.isDuplicate(spanCtx, topic, msg, sub) {
+			messages = append(messages, msg)
+		}
+	}
+
+	failed := make(map[*pubsub.Message]error)
+
+	if len(messages) > 0 {
+		start := s.startProcessing(spanCtx, topic, sub, len(messages))
+
+		var errs []error
+
+		for i, err := range s.callBatchHandler(spanCtx, topic, messages, sub) {
+			failed[messages[i]] = err
+			errs = append(errs, err)
+		}
+
+		s.endProcessing(spanCtx, topic, sub, len(messages), start, errs...)
+	}
+
+	for _, msg := range messages {
+		if _, ok := failed[msg]; !ok {
+			s.markProcessed(topic, msg, sub)
+		}
+	}
+
+	commit := make([]bool, len(batch))
+
+	for i, msg := range batch {
+		err, ok := failed[msg]
+		if !ok {
+			commit[i] = true
+
+			continue
+		}
+
+		s.container.Logger.Errorf("error in handler for message of topic %s: %v", topic, err)
+
+		// the message is committed only once it is published to the dead-letter topic.
+		commit[i] = s.deadLetter(topic, msg, sub.retry, err)
+	}
+
+	sub.commits.commitBatch(batch, commit)
+}
+
+// callBatchHandler calls the handler with the batch, and then with its failed messages as per the retry policy.
+// It returns the errors of the messages which failed in the last attempt, by their index in the batch.
+func (s *SubscriptionManager) callBatchHandler(ctx context.Context, topic string, batch []*pubsub.Message,
+	sub *subscription) map[int]error {
+	attempts := sub.retry.attempts()
+
+	// indexes are the indexes in the batch of the messages passed to the handler.
+	indexes := make([]int, len(batch))
+	for i := range batch {
+		indexes[i] = i
+	}
+
+	var failed map[int]error
+
+	for attempt := 1; ; attempt++ {
+		messages := make([]*pubsub.Message, len(indexes))
+		for i, index := range indexes {
+			messages[i] = batch[index]
+		}
+
+		failed = s.callBatchHandlerOnce(ctx, topic, messages, indexes, sub.batch.handler)
+		if len(failed) == 0 || attempt >= attempts {
+			return failed
+		}
+
+		s.container.Logger.Errorf("error in handler for %d messages of topic %s, attempt %d of %d", len(failed), topic,
+			attempt, attempts)
+		s.container.Metrics().IncrementCounter(ctx, "app_pubsub_subscribe_retry_count", "topic", topic)
+
+		select {
+		case <-ctx.Done():
+			return failed
+		case <-time.After(sub.retry.backoff(attempt)):
+		}
+
+		indexes = indexes[:0]
+		for index := range batch {
+			if _, ok := failed[index]; ok {
+				indexes = append(indexes, index)
+			}
+		}
+	}
+}
+
+// callBatchHandlerOnce calls the handler with the messages, returning the errors of the failed messages by their
+// indexes in the batch.
+func (s *SubscriptionManager) callBatchHandlerOnce(ctx context.Context, topic string, messages []*pubsub.Message,
+	indexes []int, handler BatchSubscribeFunc) map[int]error {
+	c := newContext(nil, &batchRequest{ctx: ctx, topic: topic, messages: messages}, s.container)
+
+	err := func() (err error) {
+		// a panic fails the whole batch, so that its messages are retried and not committed.
+		defer func() {
+			if re := recover(); re != nil {
+				panicRecovery(re, c.Logger)
+
+				err = errBatchHandlerPanic
+			}
+		}()
+
+		return handler(c, messages)
+	}()
+	if err == nil {
+		return nil
+	}
+
+	var batchErr *BatchError
+
+	// a nil *BatchError returned as a non-nil error, e.g. through a named *BatchError result, fails no message.
+	isBatchErr := errors.As(err, &batchErr)
+	if isBatchErr && batchErr == nil {
+		return nil
+	}
+
+	failed := make(map[int]error)
+
+	if !isBatchErr {
+		for _, index := range indexes {
+			failed[index] = err
+		}
+
+		return failed
+	}
+
+	for i, msgErr := range batchErr.Failed {
+		if i >= 0 && i < len(indexes) {
+			failed[indexes[i]] = msgErr
+		}
+	}
+
+	return failed
+}
+
+// commitBatch commits the messages of the batch to be committed, and rejects the others. As committing a message
+// commits the earlier messages of its partition as well, only the last message of each partition is committed,
+// and a message not to be committed stops the commits of its partition as in done.
+func (t *commitTracker) commitBatch(batch []*pubsub.Message, commit []bool) {
+	last := make(map[*partitionCommits]*pubsub.Message)
+
+	for i, msg := range batch {
+		p := t.partition(msg)
+
+		if !commit[i] || msg.Committer == nil {
+			if p != nil {
+				p.mu.Lock()
+				p.blocked = true
+				p.mu.Unlock()
+			}
+
+			reject(msg)
+
+			continue
+		}
+
+		if p == nil {
+			msg.Commit()
+
+			continue
+		}
+
+		p.mu.Lock()
+		if !p.blocked {
+			last[p] = msg
+		}
+		p.mu.Unlock()
+	}
+
+	for _, msg := range last {
+		msg.Commit()
+	}
+}
+
+// batchRequest is the Request of the Context of a BatchSubscribeFunc.
+type batchRequest struct {
+	ctx      context.Context
+	topic    string
+	messages []*pubsub.Message
+}
+
+func (r *batchRequest) Context() context.Context {
+	return r.ctx
+}
+
+func (r *batchRequest) Param(p string) string {
+	if p == "topic" {
+		return r.topic
+	}
+
+	return ""
+}
+
+func (r *batchRequest) PathParam(p string) string {
+	return r.Param(p)
+}
+
+// Bind binds the values of the messages of the batch to the elements of a slice, the input being a pointer
+// to the slice, e.g. *[]Order.
+func (r *batchRequest) Bind(i any) error {
+	v := reflect.ValueOf(i)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Slice {
+		return errNotSlicePointer
+	}
+
+	slice := reflect.MakeSlice(v.Elem().Type(), len(r.messages), len(r.messages))
+
+	for j, msg := range r.messages {
+		if err := msg.Bind(slice.Index(j).Addr().Interface()); err != nil {
+			return fmt.Errorf("message %d: %w", j, err)
+		}
+	}
+
+	v.Elem().Set(slice)
+
+	return nil
+}
+
+func (*batchRequest) HostName() string {
+	return ""
+}
+
+func (*batchRequest) Params(string) []string {
+	return nil
+}
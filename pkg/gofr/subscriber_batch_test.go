@@ -0,0 +1,313 @@
+package gofr
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+	"gofr.dev/pkg/gofr/logging"
+	"gofr.dev/pkg/gofr/testutil"
+)
+
+func TestSubscriptionManager_startBatchSubscriber(t *testing.T) {
+	const messages = 5
+
+	c, mocks := container.NewMockContainer(t)
+	expectProcessingMetrics(mocks, "orders")
+	ctx, cancel := context.WithCancel(t.Context())
+
+	var (
+		read    int
+		handled int
+		commits []int
+		sizes   []int
+	)
+
+	mocks.PubSub.EXPECT().Subscribe(gomock.Any(), "orders").DoAndReturn(func(ctx context.Context, _ string) (*pubsub.Message, error) {
+		if read == messages {
+			<-ctx.Done()
+
+			return nil, ctx.Err()
+		}
+
+		msg := pubsub.NewMessage(t.Context())
+		msg.Value = []byte(strconv.Itoa(read))
+		msg.Committer = &mockPartitionCommitter{offset: read, commits: &commits}
+
+		read++
+
+		return msg, nil
+	}).AnyTimes()
+
+	handler := func(c *Context, batch []*pubsub.Message) error {
+		var values []int
+
+		require.NoError(t, c.Bind(&values))
+		assert.Len(t, values, len(batch))
+
+		sizes = append(sizes, len(batch))
+
+		handled += len(batch)
+		if handled == messages {
+			cancel()
+		}
+
+		return nil
+	}
+
+	sub := newSubscription(nil)
+	sub.batch = &batchSubscription{handler: handler, maxSize: 2, maxWait: 200 * time.Millisecond}
+
+	s := newSubscriptionManager(c)
+
+	err := s.startBatchSubscriber(ctx, "orders", sub)
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{2, 2, 1}, sizes, "the batches are not handled by size and wait")
+	assert.Equal(t, []int{1, 3, 4}, commits, "the last message of each batch is not committed")
+}
+
+func TestSubscriptionManager_handleBatch(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 2, Backoff: time.Millisecond}
+	dlqPolicy := RetryPolicy{MaxAttempts: 2, Backoff: time.Millisecond, DeadLetterTopic: "orders-dlq"}
+
+	testCases := []struct {
+		desc      string
+		failing   map[string]int
+		wholeErr  bool
+		policy    *RetryPolicy
+		calls     []int
+		retries   int
+		published int
+		committed []bool
+	}{
+		{desc: "batch succeeds", calls: []int{3}, committed: []bool{true, true, true}},
+		{desc: "partial failure without retry policy", failing: map[string]int{"1": 1}, calls: []int{3},
+			committed: []bool{true, false, true}},
+		{desc: "partial failure retried", failing: map[string]int{"1": 1}, policy: &policy, calls: []int{3, 1},
+			retries: 1, committed: []bool{true, true, true}},
+		{desc: "partial failure dead-lettered", failing: map[string]int{"0": 2, "2": 1}, policy: &dlqPolicy,
+			calls: []int{3, 2}, retries: 1, published: 1, committed: []bool{true, true, true}},
+		{desc: "partial failure not dead-lettered", failing: map[string]int{"0": 2}, policy: &policy,
+			calls: []int{3, 1}, retries: 1, committed: []bool{false, true, true}},
+		{desc: "whole batch fails", wholeErr: true, failing: map[string]int{"0": 1}, policy: &policy,
+			calls: []int{3, 3}, retries: 1, committed: []bool{true, true, true}},
+	}
+
+	for i, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			c, mocks := container.NewMockContainer(t)
+			expectProcessingMetrics(mocks, "orders")
+
+			mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_subscribe_retry_count", "topic", "orders").
+				Times(tc.retries)
+			newOptionsPubSub(t, c).EXPECT().PublishWithOptions(gomock.Any(), "orders-dlq", []byte("0"), gomock.Any()).
+				Return(nil).Times(tc.published)
+			mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_dead_letter_count",
+				"topic", "orders", "dead_letter_topic", "orders-dlq").Times(tc.published)
+
+			batch := make([]*pubsub.Message, 3)
+			committers := make([]*mockCommitter, 3)
+
+			for j := range batch {
+				committers[j] = &mockCommitter{}
+				batch[j] = pubsub.NewMessage(t.Context())
+				batch[j].Value = []byte(strconv.Itoa(j))
+				batch[j].Committer = committers[j]
+			}
+
+			var calls []int
+
+			// handler fails each message in failing for the given number of calls.
+			handler := func(_ *Context, messages []*pubsub.Message) error {
+				calls = append(calls, len(messages))
+
+				batchErr := &BatchError{Failed: make(map[int]error)}
+
+				for j, msg := range messages {
+					if tc.failing[string(msg.Value)] >= len(calls) {
+						batchErr.Failed[j] = errHandler
+					}
+				}
+
+				switch {
+				case len(batchErr.Failed) == 0:
+					return nil
+				case tc.wholeErr:
+					return errHandler
+				default:
+					return batchErr
+				}
+			}
+
+			sub := newSubscription(nil)
+			sub.retry = tc.policy
+			sub.batch = &batchSubscription{handler: handler, maxSize: 3, maxWait: time.Second}
+
+			s := newSubscriptionManager(c)
+			s.handleBatch(t.Context(), "orders", batch, sub)
+
+			assert.Equal(t, tc.calls, calls, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+			for j, committer := range committers {
+				assert.Equal(t, tc.committed[j], committer.committed, "TEST[%d], Failed.\n%s: message %d", i, tc.desc, j)
+			}
+		})
+	}
+}
+
+func TestSubscriptionManager_handleBatch_Panic(t *testing.T) {
+	c, mocks := container.NewMockContainer(t)
+	expectProcessingMetrics(mocks, "orders")
+
+	committer := &mockCommitter{}
+	msg := pubsub.NewMessage(t.Context())
+	msg.Committer = committer
+
+	sub := newSubscription(nil)
+	sub.batch = &batchSubscription{handler: func(*Context, []*pubsub.Message) error {
+		panic("batch handler")
+	}, maxSize: 1, maxWait: time.Second}
+
+	s := newSubscriptionManager(c)
+	s.handleBatch(t.Context(), "orders", []*pubsub.Message{msg}, sub)
+
+	assert.False(t, committer.committed, "the message of a panicking handler is committed")
+}
+
+func TestSubscriptionManager_handleBatch_NilBatchError(t *testing.T) {
+	c, mocks := container.NewMockContainer(t)
+	expectProcessingMetrics(mocks, "orders")
+
+	committer := &mockCommitter{}
+	msg := pubsub.NewMessage(t.Context())
+	msg.Committer = committer
+
+	// handler returns a nil *BatchError as a non-nil error through its named result.
+	handler := func(*Context, []*pubsub.Message) (err error) {
+		var batchErr *BatchError
+
+		return batchErr
+	}
+
+	sub := newSubscription(nil)
+	sub.batch = &batchSubscription{handler: handler, maxSize: 1, maxWait: time.Second}
+
+	s := newSubscriptionManager(c)
+
+	require.NotPanics(t, func() { s.handleBatch(t.Context(), "orders", []*pubsub.Message{msg}, sub) })
+	assert.True(t, committer.committed, "the message of a nil *BatchError is not committed")
+}
+
+func TestCommitBatch(t *testing.T) {
+	var first, second, third []int
+
+	committer := &mockCommitter{}
+	rejecter := &mockRejecter{}
+
+	batch := []*pubsub.Message{
+		{Committer: &mockPartitionCommitter{partition: 0, offset: 0, commits: &first}},
+		{Committer: &mockPartitionCommitter{partition: 1, offset: 0, commits: &second}},
+		{Committer: &mockPartitionCommitter{partition: 2, offset: 0, commits: &third}},
+		{Committer: &mockPartitionCommitter{partition: 0, offset: 1, commits: &first}},
+		{Committer: &mockPartitionCommitter{partition: 1, offset: 1, commits: &second}},
+		{Committer: &mockPartitionCommitter{partition: 2, offset: 1, commits: &third}},
+		{Committer: &mockPartitionCommitter{partition: 1, offset: 2, commits: &second}},
+		{Committer: committer},
+		{},
+		{Committer: rejecter},
+	}
+
+	tracker := newCommitTracker()
+	tracker.commitBatch(batch, []bool{true, true, false, true, false, true, true, true, true, false})
+
+	assert.Equal(t, []int{1}, first, "only the last message of the partition is committed")
+	assert.Equal(t, []int{0}, second, "the messages from the first message not to be committed are committed")
+	assert.Empty(t, third, "the messages after the first message not to be committed are committed")
+	assert.True(t, committer.committed)
+	assert.True(t, rejecter.rejected, "the message not to be committed is not rejected")
+	assert.False(t, rejecter.committed)
+
+	tracker.commitBatch([]*pubsub.Message{
+		{Committer: &mockPartitionCommitter{partition: 0, offset: 2, commits: &first}},
+		{Committer: &mockPartitionCommitter{partition: 1, offset: 3, commits: &second}},
+	}, []bool{true, true})
+
+	assert.Equal(t, []int{1, 2}, first)
+	assert.Equal(t, []int{0}, second, "a later batch commits past the message not to be committed")
+}
+
+func TestBatchRequest(t *testing.T) {
+	messages := []*pubsub.Message{{Value: []byte(`{"id":1}`)}, {Value: []byte(`{"id":2}`)}}
+	r := &batchRequest{ctx: t.Context(), topic: "orders", messages: messages}
+
+	type order struct {
+		ID int `json:"id"`
+	}
+
+	var orders []order
+
+	require.NoError(t, r.Bind(&orders))
+	assert.Equal(t, []order{{ID: 1}, {ID: 2}}, orders)
+
+	var o order
+
+	require.ErrorIs(t, r.Bind(&o), errNotSlicePointer)
+
+	r.messages = append(r.messages, &pubsub.Message{Value: []byte(`invalid`)})
+	require.Error(t, r.Bind(&orders))
+
+	assert.Equal(t, "orders", r.Param("topic"))
+	assert.Equal(t, "orders", r.PathParam("topic"))
+	assert.Empty(t, r.Param("id"))
+	assert.Equal(t, t.Context(), r.Context())
+}
+
+func TestApp_SubscribeBatch(t *testing.T) {
+	handler := func(*Context, []*pubsub.Message) error { return nil }
+
+	testCases := []struct {
+		desc       string
+		topic      string
+		handler    BatchSubscribeFunc
+		maxSize    int
+		maxWait    time.Duration
+		subscribed bool
+	}{
+		{desc: "valid subscription", topic: "orders", handler: handler, maxSize: 10, maxWait: time.Second, subscribed: true},
+		{desc: "topic is empty", handler: handler, maxSize: 10, maxWait: time.Second},
+		{desc: "handler is nil", topic: "orders", maxSize: 10, maxWait: time.Second},
+		{desc: "max size is not positive", topic: "orders", handler: handler, maxWait: time.Second},
+		{desc: "max wait is not positive", topic: "orders", handler: handler, maxSize: 10},
+	}
+
+	for i, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			testutil.NewServerConfigs(t)
+
+			app := New()
+			app.container = &container.Container{
+				Logger: logging.NewLogger(logging.ERROR),
+				PubSub: mockSubscriber{},
+			}
+
+			app.SubscribeBatch(tc.topic, tc.handler, tc.maxSize, tc.maxWait, WithRetryPolicy(RetryPolicy{MaxAttempts: 2}))
+
+			sub, ok := app.subscriptionManager.subscriptions[tc.topic]
+
+			require.Equal(t, tc.subscribed, ok, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+			if ok {
+				assert.Equal(t, 10, sub.batch.maxSize, "TEST[%d], Failed.\n%s", i, tc.desc)
+				assert.Equal(t, 2, sub.retry.MaxAttempts, "TEST[%d], Failed.\n%s", i, tc.desc)
+			}
+		})
+	}
+}
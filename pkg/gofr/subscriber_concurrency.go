@@ -0,0 +1,219 @@
+package gofr
+
+import (
+	"context"
+	"hash/fnv"
+	"strconv"
+	"sync"
+	"time"
+
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+)
+
+// WithConcurrency sets the number of workers handling the messages of the subscription concurrently.
+//
+// The messages with the same key, or of the same partition when they have no key, are handled by the same worker
+// in the order they are read. Each worker holds at most one message waiting to be handled, so that the reading
+// of the messages is paused while the workers are busy, bounding the messages in flight.
+func WithConcurrency(n int) SubscribeOption {
+	return func(s *subscription) {
+		s.concurrency = n
+	}
+}
+
+// startConcurrentSubscriber reads the messages of the topic and dispatches them to the workers of the subscription,
+// until ctx is done. The messages in flight are handled before it returns.
+func (s *SubscriptionManager) startConcurrentSubscriber(ctx context.Context, topic string, sub *subscription) error {
+	commits := sub.commits
+	workers := make([]chan *pubsub.Message, sub.concurrency)
+
+	var wg sync.WaitGroup
+
+	for i := range workers {
+		workers[i] = make(chan *pubsub.Message, 1)
+
+		wg.Add(1)
+
+		go func(messages <-chan *pubsub.Message) {
+			defer wg.Done()
+
+			for msg := range messages {
+				commits.done(msg, s.processMessage(ctx, topic, msg, sub))
+			}
+		}(workers[i])
+	}
+
+	defer func() {
+		for _, w := range workers {
+			close(w)
+		}
+
+		wg.Wait()
+	}()
+
+	var (
+		delay time.Duration
+		next  int
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.container.Logger.Infof("shutting down subscriber for topic %s", topic)
+			return nil
+		case <-time.After(delay):
+		}
+
+		msg, err := s.container.GetSubscriber().Subscribe(ctx, topic)
+		sub.status.read(err)
+
+		if err != nil {
+			s.container.Logger.Errorf("error while reading from topic %v, err: %v", topic, err.Error())
+
+			delay = time.Second * 2
+
+			continue
+		}
+
+		delay = 0
+
+		if msg == nil {
+			continue
+		}
+
+		commits.add(msg)
+
+		select {
+		case workers[workerIndex(msg, len(workers), &next)] <- msg:
+		case <-ctx.Done():
+			s.container.Logger.Infof("shutting down subscriber for topic %s", topic)
+			return nil
+		}
+	}
+}
+
+// workerIndex returns the worker of the message as per the hash of its key, or of its partition when it has no key,
+// so that the messages with the same key or partition are handled in order. The other messages are dispatched
+// to the workers in round-robin.
+func workerIndex(msg *pubsub.Message, workers int, next *int) int {
+	key := msg.Key
+
+	if pc, ok := msg.Committer.(pubsub.PartitionCommitter); ok && key == "" {
+		key = "partition-" + strconv.Itoa(pc.Partition())
+	}
+
+	if key == "" {
+		*next = (*next + 1) % workers
+
+		return *next
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+
+	return int(h.Sum32() % uint32(workers)) //nolint:gosec // the number of workers is positive.
+}
+
+// commitTracker commits the messages of a subscription. The messages of the partitions, e.g. of Kafka, are
+// committed only when all the messages read before them from the partition are handled, as committing a message
+// commits the earlier messages of its partition as well. A message not to be committed stops the commits of its
+// partition, till the subscriber restarts, so that it is read again along with the later messages of the partition
+// once the app restarts or the partition is assigned to another consumer.
+type commitTracker struct {
+	mu         sync.Mutex
+	partitions map[int]*partitionCommits
+}
+
+// partitionCommits are the messages of a partition which are read and not yet committed, in the order they are read.
+type partitionCommits struct {
+	mu      sync.Mutex
+	pending []*pendingCommit
+	// blocked is set once a message of the partition is not to be committed, after which no message is committed.
+	blocked bool
+}
+
+type pendingCommit struct {
+	msg    *pubsub.Message
+	done   bool
+	commit bool
+}
+
+func newCommitTracker() *commitTracker {
+	return &commitTracker{partitions: make(map[int]*partitionCommits)}
+}
+
+// add records the message as read, before it is dispatched to a worker.
+func (t *commitTracker) add(msg *pubsub.Message) {
+	p := t.partition(msg)
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	p.pending = append(p.pending, &pendingCommit{msg: msg})
+	p.mu.Unlock()
+}
+
+// done records the message as handled, committing it when commit is set, and rejecting it otherwise. For the
+// messages of a partition, the last message to be committed, out of the handled messages read before any unhandled
+// message and any message not to be committed, is committed.
+func (t *commitTracker) done(msg *pubsub.Message, commit bool) {
+	if !commit || msg.Committer == nil {
+		reject(msg)
+	}
+
+	p := t.partition(msg)
+	if p == nil {
+		if commit && msg.Committer != nil {
+			msg.Commit()
+		}
+
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, pc := range p.pending {
+		if pc.msg == msg {
+			pc.done, pc.commit = true, commit
+
+			break
+		}
+	}
+
+	var last *pubsub.Message
+
+	for len(p.pending) > 0 && p.pending[0].done {
+		p.blocked = p.blocked || !p.pending[0].commit
+
+		if !p.blocked {
+			last = p.pending[0].msg
+		}
+
+		p.pending = p.pending[1:]
+	}
+
+	// the commit is made holding the lock, so that the commits of the partition are made in order.
+	if last != nil {
+		last.Commit()
+	}
+}
+
+func (t *commitTracker) partition(msg *pubsub.Message) *partitionCommits {
+	pc, ok := msg.Committer.(pubsub.PartitionCommitter)
+	if !ok {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p, ok := t.partitions[pc.Partition()]
+	if !ok {
+		p = &partitionCommits{}
+		t.partitions[pc.Partition()] = p
+	}
+
+	return p
+}
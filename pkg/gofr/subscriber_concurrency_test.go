@@ -0,0 +1,165 @@
+package gofr
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+)
+
+// mockPartitionCommitter records the commits of the messages of a partition in commits.
+type mockPartitionCommitter struct {
+	partition int
+	offset    int
+	commits   *[]int
+}
+
+func (m *mockPartitionCommitter) Commit() {
+	*m.commits = append(*m.commits, m.offset)
+}
+
+func (m *mockPartitionCommitter) Partition() int {
+	return m.partition
+}
+
+// mockPartitionRejecter is the mockPartitionCommitter of a broker which is notified of the rejected messages.
+type mockPartitionRejecter struct {
+	mockPartitionCommitter
+
+	rejected bool
+}
+
+func (m *mockPartitionRejecter) Reject() {
+	m.rejected = true
+}
+
+func TestWorkerIndex(t *testing.T) {
+	var next int
+
+	keyed := &pubsub.Message{Key: "order-1"}
+	assert.Equal(t, workerIndex(keyed, 4, &next), workerIndex(keyed, 4, &next))
+
+	partitioned := &pubsub.Message{Committer: &mockPartitionCommitter{partition: 2}}
+	assert.Equal(t, workerIndex(partitioned, 4, &next), workerIndex(partitioned, 4, &next))
+
+	unordered := &pubsub.Message{}
+	assert.Equal(t, 1, workerIndex(unordered, 4, &next))
+	assert.Equal(t, 2, workerIndex(unordered, 4, &next))
+}
+
+func TestCommitTracker(t *testing.T) {
+	var commits []int
+
+	msgs := make([]*pubsub.Message, 5)
+
+	tracker := newCommitTracker()
+
+	for i := range msgs {
+		msgs[i] = &pubsub.Message{Committer: &mockPartitionCommitter{offset: i, commits: &commits}}
+		tracker.add(msgs[i])
+	}
+
+	tracker.done(msgs[1], true)
+	assert.Empty(t, commits, "a message is not committed before the earlier messages are handled")
+
+	tracker.done(msgs[0], true)
+	assert.Equal(t, []int{1}, commits, "the last of the contiguous handled messages is committed")
+
+	tracker.done(msgs[3], true)
+	tracker.done(msgs[2], false)
+	assert.Equal(t, []int{1}, commits, "the messages after a message not to be committed are committed")
+
+	tracker.done(msgs[4], true)
+	assert.Equal(t, []int{1}, commits, "the partition is committed after a message not to be committed")
+
+	rejecter := &mockPartitionRejecter{mockPartitionCommitter: mockPartitionCommitter{partition: 1, commits: &commits}}
+	rejected := &pubsub.Message{Committer: rejecter}
+
+	tracker.add(rejected)
+	tracker.done(rejected, false)
+	assert.True(t, rejecter.rejected, "the message of a partition not to be committed is not rejected")
+
+	committer := &mockCommitter{}
+	tracker.done(&pubsub.Message{Committer: committer}, true)
+	assert.True(t, committer.committed, "the messages without partitions are committed as they are handled")
+}
+
+func TestSubscriptionManager_startConcurrentSubscriber(t *testing.T) {
+	const messages = 40
+
+	c, mocks := container.NewMockContainer(t)
+	expectProcessingMetrics(mocks, "orders")
+	ctx, cancel := context.WithCancel(t.Context())
+
+	var (
+		read       int
+		commits    []int
+		mu         sync.Mutex
+		handled    = make(map[string][]int)
+		inFlight   int
+		concurrent int
+	)
+
+	mocks.PubSub.EXPECT().Subscribe(gomock.Any(), "orders").DoAndReturn(func(ctx context.Context, _ string) (*pubsub.Message, error) {
+		if read == messages {
+			<-ctx.Done()
+
+			return nil, ctx.Err()
+		}
+
+		msg := pubsub.NewMessage(t.Context())
+		msg.Key = "order-" + strconv.Itoa(read%4)
+		msg.Value = []byte(strconv.Itoa(read))
+		msg.Committer = &mockPartitionCommitter{offset: read, commits: &commits}
+
+		read++
+
+		return msg, nil
+	}).AnyTimes()
+
+	s := newSubscriptionManager(c)
+
+	handler := func(c *Context) error {
+		mu.Lock()
+		inFlight++
+		concurrent = max(concurrent, inFlight)
+		mu.Unlock()
+
+		time.Sleep(time.Millisecond)
+
+		var n int
+
+		_ = c.Bind(&n)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		inFlight--
+		handled[c.Request.(*pubsub.Message).Key] = append(handled[c.Request.(*pubsub.Message).Key], n)
+
+		if n == messages-1 {
+			cancel()
+		}
+
+		return nil
+	}
+
+	err := s.startConcurrentSubscriber(ctx, "orders", newSubscription(handler, WithConcurrency(4)))
+	require.NoError(t, err)
+
+	for key, values := range handled {
+		assert.IsIncreasing(t, values, "the messages of %s are not handled in order", key)
+	}
+
+	assert.Greater(t, concurrent, 1, "the messages are not handled concurrently")
+	assert.IsIncreasing(t, commits, "the messages are not committed in order")
+	assert.Equal(t, messages-1, commits[len(commits)-1], "the last message is not committed")
+}
@@ -0,0 +1,107 @@
+package gofr
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+	"gofr.dev/pkg/gofr/dedup"
+)
+
+const defaultDeduplicationTTL = 24 * time.Hour
+
+// Deduplication is the deduplication of the messages of a subscription, skipping the messages which are already
+// processed, for the brokers delivering the messages at least once.
+type Deduplication struct {
+	// Store records the IDs of the processed messages. It defaults to a store on the Redis, SQL or KVStore datasource
+	// of the app, the first which is configured.
+	Store dedup.Store
+	// IDHeader is the header carrying the ID of a message, e.g. set by its publisher. The ID of a message without
+	// the header is the SHA-256 hash of its value.
+	IDHeader string
+	// TTL is the time for which the IDs of the processed messages are recorded. It defaults to 24 hours.
+	TTL time.Duration
+}
+
+// WithDeduplication sets the deduplication of the messages of the subscription. A message whose ID is recorded as
+// processed is committed without calling the handler, and the ID of a message is recorded once the handler
+// succeeds. The messages delivered again while they are being handled are not skipped, so the handler should
+// still tolerate the rare duplicates.
+func WithDeduplication(d Deduplication) SubscribeOption {
+	return func(s *subscription) {
+		if d.TTL <= 0 {
+			d.TTL = defaultDeduplicationTTL
+		}
+
+		s.dedup = &d
+	}
+}
+
+// defaultDeduplicationStore returns the store on the Redis, SQL or KVStore datasource of the container, the first
+// which is configured, or nil when none is configured.
+func defaultDeduplicationStore(c *container.Container) dedup.Store {
+	switch {
+	case !isNil(c.Redis):
+		return dedup.NewRedisStore(c.Redis)
+	case !isNil(c.SQL):
+		return dedup.NewSQLStore(c.SQL)
+	case !isNil(c.KVStore):
+		return dedup.NewKVStore(c.KVStore)
+	default:
+		return nil
+	}
+}
+
+// key returns the key of the message in the store, the ID of the message for the topic and the app, so that the
+// store can be shared by the apps.
+func (d *Deduplication) key(appName, topic string, msg *pubsub.Message) string {
+	id := ""
+
+	if d.IDHeader != "" {
+		id = msg.Header(d.IDHeader)
+	}
+
+	if id == "" {
+		hash := sha256.Sum256(msg.Value)
+		id = hex.EncodeToString(hash[:])
+	}
+
+	return appName + ":" + topic + ":" + id
+}
+
+// isDuplicate reports whether the message is already processed, as per the deduplication of the subscription.
+// A message whose ID cannot be read from the store is processed.
+func (s *SubscriptionManager) isDuplicate(ctx context.Context, topic string, msg *pubsub.Message, sub *subscription) bool {
+	if sub.dedup == nil {
+		return false
+	}
+
+	processed, err := sub.dedup.Store.Processed(msg.Context(), sub.dedup.key(s.container.GetAppName(), topic, msg))
+	if err != nil {
+		s.container.Logger.Errorf("error reading the deduplication store for message of topic %s: %v", topic, err)
+
+		return false
+	}
+
+	if processed {
+		s.container.Logger.Debugf("skipping duplicate message of topic %s", topic)
+		s.container.Metrics().IncrementCounter(ctx, "app_pubsub_duplicate_count", "topic", topic)
+	}
+
+	return processed
+}
+
+// markProcessed records the message as processed, as per the deduplication of the subscription.
+func (s *SubscriptionManager) markProcessed(topic string, msg *pubsub.Message, sub *subscription) {
+	if sub.dedup == nil {
+		return
+	}
+
+	err := sub.dedup.Store.MarkProcessed(msg.Context(), sub.dedup.key(s.container.GetAppName(), topic, msg), sub.dedup.TTL)
+	if err != nil {
+		s.container.Logger.Errorf("error recording processed message of topic %s: %v", topic, err)
+	}
+}
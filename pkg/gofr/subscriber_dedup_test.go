@@ -0,0 +1,220 @@
+package gofr
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+	"gofr.dev/pkg/gofr/dedup"
+	"gofr.dev/pkg/gofr/logging"
+	"gofr.dev/pkg/gofr/testutil"
+)
+
+var errStore = errors.New("store error")
+
+// mockDedupStore records the processed keys in memory, failing the reads when err is set.
+type mockDedupStore struct {
+	keys map[string]time.Duration
+	err  error
+}
+
+func (m *mockDedupStore) Processed(_ context.Context, key string) (bool, error) {
+	_, ok := m.keys[key]
+
+	return ok, m.err
+}
+
+func (m *mockDedupStore) MarkProcessed(_ context.Context, key string, ttl time.Duration) error {
+	m.keys[key] = ttl
+
+	return nil
+}
+
+func newDedupMessage(ctx context.Context, value string, headers map[string]string) (*pubsub.Message, *mockCommitter) {
+	committer := &mockCommitter{}
+
+	msg := pubsub.NewMessage(ctx)
+	msg.Topic = "orders"
+	msg.Value = []byte(value)
+	msg.Committer = committer
+	msg.SetHeaders(headers)
+
+	return msg, committer
+}
+
+func TestSubscriptionManager_Deduplication(t *testing.T) {
+	c, mocks := container.NewMockContainer(t)
+	expectProcessingMetrics(mocks, "orders")
+
+	mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_duplicate_count", "topic", "orders").Times(2)
+
+	store := &mockDedupStore{keys: make(map[string]time.Duration)}
+
+	var handled []string
+
+	fail := true
+
+	sub := newSubscription(func(c *Context) error {
+		msg := c.Request.(*pubsub.Message)
+		handled = append(handled, string(msg.Value))
+
+		if string(msg.Value) == "failing" && fail {
+			fail = false
+
+			return errSubscription
+		}
+
+		return nil
+	}, WithDeduplication(Deduplication{Store: store, IDHeader: "x-message-id"}))
+
+	s := newSubscriptionManager(c)
+
+	deliver := func(value string, headers map[string]string) bool {
+		msg, _ := newDedupMessage(t.Context(), value, headers)
+
+		return s.processMessage(t.Context(), "orders", msg, sub)
+	}
+
+	assert.True(t, deliver("order-1", nil))
+	assert.True(t, deliver("order-1", nil), "the duplicate message is not committed")
+
+	// the messages with the same ID header are duplicates, whatever their values.
+	assert.True(t, deliver("order-2", map[string]string{"x-message-id": "2"}))
+	assert.True(t, deliver("order-2-again", map[string]string{"x-message-id": "2"}))
+
+	// the message for which the handler fails is not recorded, so that it is handled when it is delivered again.
+	assert.False(t, deliver("failing", nil))
+	assert.True(t, deliver("failing", nil))
+
+	assert.Equal(t, []string{"order-1", "order-2", "failing", "failing"}, handled)
+	assert.Contains(t, store.keys, c.GetAppName()+":orders:2")
+
+	for _, ttl := range store.keys {
+		assert.Equal(t, 24*time.Hour, ttl)
+	}
+
+	// the message is handled when the store cannot be read.
+	store.err = errStore
+
+	assert.True(t, deliver("order-1", nil))
+	assert.Len(t, handled, 5)
+}
+
+func TestSubscriptionManager_DeduplicationBatch(t *testing.T) {
+	c, mocks := container.NewMockContainer(t)
+	expectProcessingMetrics(mocks, "orders")
+
+	mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_duplicate_count", "topic", "orders").Times(3)
+
+	kv := dedup.NewKVStore(&mockKVStore{values: make(map[string]string)})
+
+	var handled [][]string
+
+	sub := newSubscription(nil, WithDeduplication(Deduplication{Store: kv, TTL: time.Minute}))
+	sub.batch = &batchSubscription{handler: func(_ *Context, messages []*pubsub.Message) error {
+		var values []string
+
+		for _, msg := range messages {
+			values = append(values, string(msg.Value))
+		}
+
+		handled = append(handled, values)
+
+		return nil
+	}, maxSize: 3, maxWait: time.Second}
+
+	s := newSubscriptionManager(c)
+
+	first, _ := newDedupMessage(t.Context(), "order-1", nil)
+	s.handleBatch(t.Context(), "orders", []*pubsub.Message{first}, sub)
+
+	duplicate, duplicateCommitter := newDedupMessage(t.Context(), "order-1", nil)
+	second, secondCommitter := newDedupMessage(t.Context(), "order-2", nil)
+	s.handleBatch(t.Context(), "orders", []*pubsub.Message{duplicate, second}, sub)
+
+	// the handler is not called for a batch of duplicates.
+	again, againCommitter := newDedupMessage(t.Context(), "order-2", nil)
+	againDuplicate, _ := newDedupMessage(t.Context(), "order-1", nil)
+	s.handleBatch(t.Context(), "orders", []*pubsub.Message{again, againDuplicate}, sub)
+
+	assert.Equal(t, [][]string{{"order-1"}, {"order-2"}}, handled)
+	assert.True(t, duplicateCommitter.committed)
+	assert.True(t, secondCommitter.committed)
+	assert.True(t, againCommitter.committed)
+}
+
+// mockKVStore is a key-value store in memory.
+type mockKVStore struct {
+	values map[string]string
+}
+
+func (m *mockKVStore) Get(_ context.Context, key string) (string, error) {
+	v, ok := m.values[key]
+	if !ok {
+		return "", errStore
+	}
+
+	return v, nil
+}
+
+func (m *mockKVStore) Set(_ context.Context, key, value string) error {
+	m.values[key] = value
+
+	return nil
+}
+
+func (m *mockKVStore) Delete(_ context.Context, key string) error {
+	delete(m.values, key)
+
+	return nil
+}
+
+func TestDeduplication_Key(t *testing.T) {
+	d := Deduplication{IDHeader: "x-message-id"}
+
+	msg, _ := newDedupMessage(t.Context(), "order-1", map[string]string{"X-Message-Id": "1"})
+	assert.Equal(t, "app:orders:1", d.key("app", "orders", msg))
+
+	// the ID of a message without the header is the SHA-256 hash of its value.
+	msg, _ = newDedupMessage(t.Context(), "order-1", nil)
+	hash := sha256.Sum256([]byte("order-1"))
+	assert.Equal(t, "app:orders:"+hex.EncodeToString(hash[:]), d.key("app", "orders", msg))
+}
+
+func TestApp_SubscribeWithDeduplication(t *testing.T) {
+	testutil.NewServerConfigs(t)
+
+	app := New()
+	app.container = &container.Container{Logger: logging.NewLogger(logging.ERROR), PubSub: mockSubscriber{}}
+
+	app.Subscribe("orders", func(*Context) error { return nil }, WithDeduplication(Deduplication{}))
+	app.SubscribeBatch("payments", func(*Context, []*pubsub.Message) error { return nil }, 10, time.Second,
+		WithDeduplication(Deduplication{}))
+
+	assert.Empty(t, app.subscriptionManager.subscriptions, "the subscriptions are registered without a store")
+
+	// the store defaults to the Redis datasource of the app.
+	c, _ := container.NewMockContainer(t)
+	app.container = c
+
+	app.Subscribe("orders", func(*Context) error { return nil }, WithDeduplication(Deduplication{}))
+	assert.IsType(t, &dedup.RedisStore{}, app.subscriptionManager.subscriptions["orders"].dedup.Store)
+
+	c.Redis = nil
+
+	app.Subscribe("orders", func(*Context) error { return nil }, WithDeduplication(Deduplication{}))
+	assert.IsType(t, &dedup.SQLStore{}, app.subscriptionManager.subscriptions["orders"].dedup.Store)
+
+	store := &mockDedupStore{}
+
+	app.Subscribe("orders", func(*Context) error { return nil }, WithDeduplication(Deduplication{Store: store}))
+	assert.Same(t, store, app.subscriptionManager.subscriptions["orders"].dedup.Store)
+}
@@ -0,0 +1,91 @@
+package gofr
+
+import (
+	"strconv"
+	"time"
+
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+)
+
+// Headers added to the messages published to the dead-letter topic, along with the headers of the original message.
+const (
+	DeadLetterErrorHeader    = "x-dead-letter-error"
+	DeadLetterTopicHeader    = "x-dead-letter-topic"
+	DeadLetterAttemptsHeader = "x-dead-letter-attempts"
+)
+
+// RetryPolicy is the policy of handling the messages of a subscription, for which the handler returns an error.
+type RetryPolicy struct {
+	// MaxAttempts is the number of times the handler is called for a message, including the first call.
+	// It defaults to 1, i.e. the handler is not retried.
+	MaxAttempts int
+	// Backoff is the time to wait before the first retry, doubled before every later retry.
+	Backoff time.Duration
+	// MaxBackoff caps the time to wait before a retry, when set.
+	MaxBackoff time.Duration
+	// DeadLetterTopic receives the message, after all the attempts fail, with the headers of the message and the
+	// error in the x-dead-letter-* headers. The message is then committed. Without a dead-letter topic,
+	// the message is not committed, as when no retry policy is set.
+	DeadLetterTopic string
+}
+
+// WithRetryPolicy sets the policy of retrying the handler and dead-lettering the messages for which it fails.
+func WithRetryPolicy(policy RetryPolicy) SubscribeOption {
+	return func(s *subscription) {
+		s.retry = &policy
+	}
+}
+
+func (p *RetryPolicy) attempts() int {
+	if p == nil || p.MaxAttempts < 1 {
+		return 1
+	}
+
+	return p.MaxAttempts
+}
+
+// backoff returns the time to wait after the failed attempt, starting at 1.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.Backoff
+
+	for i := 1; i < attempt && (p.MaxBackoff == 0 || delay < p.MaxBackoff); i++ {
+		delay *= 2
+	}
+
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+
+	return delay
+}
+
+// deadLetter publishes the message, for which the handler failed, to the dead-letter topic of the policy.
+// It reports whether the message is published, so that it can be committed.
+func (s *SubscriptionManager) deadLetter(topic string, msg *pubsub.Message, policy *RetryPolicy, handlerErr error) bool {
+	if policy == nil || policy.DeadLetterTopic == "" {
+		return false
+	}
+
+	headers := make(map[string]string, len(msg.Headers())+3)
+	for k, v := range msg.Headers() {
+		headers[k] = v
+	}
+
+	headers[DeadLetterErrorHeader] = handlerErr.Error()
+	headers[DeadLetterTopicHeader] = topic
+	headers[DeadLetterAttemptsHeader] = strconv.Itoa(policy.attempts())
+
+	err := pubsub.PublishWithOptions(msg.Context(), s.container.GetPublisher(), policy.DeadLetterTopic, msg.Value,
+		pubsub.PublishOptions{Headers: headers, Key: msg.Key})
+	if err != nil {
+		s.container.Logger.Errorf("error publishing message of topic %s to dead-letter topic %s: %v",
+			topic, policy.DeadLetterTopic, err)
+
+		return false
+	}
+
+	s.container.Metrics().IncrementCounter(msg.Context(), "app_pubsub_dead_letter_count",
+		"topic", topic, "dead_letter_topic", policy.DeadLetterTopic)
+
+	return true
+}
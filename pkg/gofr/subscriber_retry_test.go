@@ -0,0 +1,241 @@
+package gofr
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+)
+
+var errHandler = errors.New("handler error")
+
+type mockCommitter struct {
+	committed bool
+}
+
+func (m *mockCommitter) Commit() {
+	m.committed = true
+}
+
+// mockRejecter is the committer of a broker which holds the messages until they are acknowledged.
+type mockRejecter struct {
+	mockCommitter
+
+	rejected bool
+}
+
+func (m *mockRejecter) Reject() {
+	m.rejected = true
+}
+
+func newRetryTestMessage(t *testing.T) (*pubsub.Message, *mockCommitter) {
+	t.Helper()
+
+	committer := &mockCommitter{}
+
+	msg := pubsub.NewMessage(t.Context())
+	msg.Topic = "orders"
+	msg.Value = []byte(`{"id":1}`)
+	msg.Key = "order-1"
+	msg.Committer = committer
+	msg.SetHeaders(map[string]string{"tenant": "gofr"})
+
+	return msg, committer
+}
+
+// newOptionsPubSub sets a MockOptionsPubSubProvider as the pub/sub of the container, for the tests of the messages
+// dead-lettered with their headers and key.
+func newOptionsPubSub(t *testing.T, c *container.Container) *container.MockOptionsPubSubProvider {
+	t.Helper()
+
+	pubSub := container.NewMockOptionsPubSubProvider(gomock.NewController(t))
+	c.PubSub = pubSub
+
+	return pubSub
+}
+
+// failingHandler fails for the first failures calls, counting the calls in calls.
+func failingHandler(calls *int, failures int) SubscribeFunc {
+	return func(*Context) error {
+		*calls++
+
+		if *calls <= failures {
+			return errHandler
+		}
+
+		return nil
+	}
+}
+
+func TestSubscriptionManager_RetryPolicy(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond, DeadLetterTopic: "orders-dlq"}
+
+	testCases := []struct {
+		desc       string
+		failures   int
+		policy     RetryPolicy
+		publishErr error
+		calls      int
+		retries    int
+		published  bool
+		committed  bool
+	}{
+		{desc: "no retry policy", failures: 1, calls: 1},
+		{desc: "success after retries", failures: 2, policy: policy, calls: 3, retries: 2, committed: true},
+		{desc: "dead-lettered", failures: 3, policy: policy, calls: 3, retries: 2, published: true, committed: true},
+		{desc: "dead-letter publish error", failures: 3, policy: policy, publishErr: errHandler, calls: 3, retries: 2,
+			published: true},
+		{desc: "no dead-letter topic", failures: 2, policy: RetryPolicy{MaxAttempts: 2}, calls: 2, retries: 1},
+	}
+
+	for i, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			c, mocks := container.NewMockContainer(t)
+			expectProcessingMetrics(mocks, "orders")
+			msg, committer := newRetryTestMessage(t)
+			pubSub := newOptionsPubSub(t, c)
+
+			pubSub.EXPECT().Subscribe(gomock.Any(), "orders").Return(msg, nil)
+			mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_subscribe_retry_count", "topic", "orders").
+				Times(tc.retries)
+
+			if tc.published {
+				pubSub.EXPECT().PublishWithOptions(gomock.Any(), "orders-dlq", msg.Value, pubsub.PublishOptions{
+					Headers: map[string]string{
+						"tenant":                 "gofr",
+						DeadLetterErrorHeader:    errHandler.Error(),
+						DeadLetterTopicHeader:    "orders",
+						DeadLetterAttemptsHeader: "3",
+					},
+					Key: "order-1",
+				}).Return(tc.publishErr)
+			}
+
+			if tc.published && tc.publishErr == nil {
+				mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_dead_letter_count",
+					"topic", "orders", "dead_letter_topic", "orders-dlq")
+			}
+
+			var calls int
+
+			options := []SubscribeOption{}
+			if tc.policy.MaxAttempts > 0 {
+				options = append(options, WithRetryPolicy(tc.policy))
+			}
+
+			s := newSubscriptionManager(c)
+
+			err := s.handleSubscription(t.Context(), "orders", newSubscription(failingHandler(&calls, tc.failures), options...))
+
+			require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+			assert.Equal(t, tc.calls, calls, "TEST[%d], Failed.\n%s", i, tc.desc)
+			assert.Equal(t, tc.committed, committer.committed, "TEST[%d], Failed.\n%s", i, tc.desc)
+		})
+	}
+}
+
+func TestSubscriptionManager_RetryPolicy_Panic(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		policy     RetryPolicy
+		publishErr error
+		committed  bool
+	}{
+		{desc: "dead-lettered", policy: RetryPolicy{MaxAttempts: 2, DeadLetterTopic: "orders-dlq"}, committed: true},
+		{desc: "dead-letter publish error", policy: RetryPolicy{MaxAttempts: 2, DeadLetterTopic: "orders-dlq"},
+			publishErr: errHandler},
+		{desc: "no dead-letter topic", policy: RetryPolicy{MaxAttempts: 2}},
+	}
+
+	for i, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			c, mocks := container.NewMockContainer(t)
+			expectProcessingMetrics(mocks, "orders")
+			msg, committer := newRetryTestMessage(t)
+			pubSub := newOptionsPubSub(t, c)
+
+			pubSub.EXPECT().Subscribe(gomock.Any(), "orders").Return(msg, nil)
+			mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_subscribe_retry_count", "topic", "orders")
+
+			if tc.policy.DeadLetterTopic != "" {
+				pubSub.EXPECT().PublishWithOptions(gomock.Any(), "orders-dlq", msg.Value, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ []byte, options pubsub.PublishOptions) error {
+						assert.Contains(t, options.Headers[DeadLetterErrorHeader], "handler panicked: order handler")
+
+						return tc.publishErr
+					})
+			}
+
+			if tc.policy.DeadLetterTopic != "" && tc.publishErr == nil {
+				mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_dead_letter_count",
+					"topic", "orders", "dead_letter_topic", "orders-dlq")
+			}
+
+			var calls int
+
+			handler := func(*Context) error {
+				calls++
+
+				panic("order handler")
+			}
+
+			s := newSubscriptionManager(c)
+
+			err := s.handleSubscription(t.Context(), "orders", newSubscription(handler, WithRetryPolicy(tc.policy)))
+
+			require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+			assert.Equal(t, 2, calls, "TEST[%d], Failed.\n%s", i, tc.desc)
+			assert.Equal(t, tc.committed, committer.committed, "TEST[%d], Failed.\n%s", i, tc.desc)
+		})
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	testCases := []struct {
+		policy  RetryPolicy
+		attempt int
+		backoff time.Duration
+	}{
+		{policy: RetryPolicy{}, attempt: 1, backoff: 0},
+		{policy: RetryPolicy{Backoff: time.Second}, attempt: 1, backoff: time.Second},
+		{policy: RetryPolicy{Backoff: time.Second}, attempt: 3, backoff: 4 * time.Second},
+		{policy: RetryPolicy{Backoff: time.Second, MaxBackoff: 3 * time.Second}, attempt: 3, backoff: 3 * time.Second},
+		{policy: RetryPolicy{Backoff: time.Second, MaxBackoff: 3 * time.Second}, attempt: 50, backoff: 3 * time.Second},
+	}
+
+	for i, tc := range testCases {
+		assert.Equal(t, tc.backoff, tc.policy.backoff(tc.attempt), "TEST[%d], Failed.", i)
+	}
+
+	assert.Equal(t, 1, (*RetryPolicy)(nil).attempts())
+	assert.Equal(t, 1, (&RetryPolicy{}).attempts())
+	assert.Equal(t, 3, (&RetryPolicy{MaxAttempts: 3}).attempts())
+}
+
+func TestSubscriptionManager_RejectsFailedMessage(t *testing.T) {
+	for _, failures := range []int{0, 1} {
+		msg, _ := newRetryTestMessage(t)
+		rejecter := &mockRejecter{}
+		msg.Committer = rejecter
+
+		c, mocks := container.NewMockContainer(t)
+		expectProcessingMetrics(mocks, "orders")
+		mocks.PubSub.EXPECT().Subscribe(gomock.Any(), "orders").Return(msg, nil)
+
+		s := newSubscriptionManager(c)
+
+		var calls int
+
+		require.NoError(t, s.handleSubscription(t.Context(), "orders", newSubscription(failingHandler(&calls, failures))))
+
+		assert.Equal(t, failures == 0, rejecter.committed)
+		assert.Equal(t, failures == 1, rejecter.rejected, "the message which is not committed is not rejected")
+	}
+}
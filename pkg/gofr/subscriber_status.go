@@ -0,0 +1,134 @@
+package gofr
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"gofr.dev/pkg/gofr/datasource"
+)
+
+// statusStarting is the status of a subscription whose subscriber has not started yet, e.g. while the app starts.
+// It does not degrade the app, unlike a subscription which is down.
+const statusStarting = "STARTING"
+
+// subscriptionStatus is the status of a subscription, reported by the health check of the app.
+type subscriptionStatus struct {
+	mu          sync.Mutex
+	started     bool
+	running     bool
+	readErr     error
+	lastMessage time.Time
+	lastErr     error
+	lastErrAt   time.Time
+	inFlight    int
+	processed   uint64
+	failed      uint64
+}
+
+// setRunning records whether the subscriber of the subscription is running.
+func (st *subscriptionStatus) setRunning(running bool) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.started = st.started || running
+	st.running = running
+	st.readErr = nil
+}
+
+// read records the result of reading a message of the subscription from the broker.
+func (st *subscriptionStatus) read(err error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.readErr = err
+}
+
+// health returns the status of the subscription, which is starting till its subscriber starts, and down when its
+// subscriber is stopped or fails to read from the broker.
+func (st *subscriptionStatus) health() datasource.Health {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	health := datasource.Health{
+		Status: datasource.StatusUp,
+		Details: map[string]any{
+			"in_flight": st.inFlight,
+			"processed": st.processed,
+			"failed":    st.failed,
+		},
+	}
+
+	if !st.lastMessage.IsZero() {
+		health.Details["last_message_at"] = st.lastMessage
+	}
+
+	if st.lastErr != nil {
+		health.Details["last_error"] = st.lastErr.Error()
+		health.Details["last_error_at"] = st.lastErrAt
+	}
+
+	switch {
+	case !st.started:
+		health.Status = statusStarting
+	case !st.running:
+		health.Status = datasource.StatusDown
+		health.Details["error"] = "subscriber is not running"
+	case st.readErr != nil:
+		health.Status = datasource.StatusDown
+		health.Details["error"] = st.readErr.Error()
+	}
+
+	return health
+}
+
+// startProcessing records the messages of the topic as in flight, returning the time their processing starts.
+func (s *SubscriptionManager) startProcessing(ctx context.Context, topic string, sub *subscription, n int) time.Time {
+	start := time.Now()
+
+	sub.status.mu.Lock()
+	sub.status.inFlight += n
+	sub.status.lastMessage = start
+	sub.status.mu.Unlock()
+
+	s.container.Metrics().DeltaUpDownCounter(ctx, "app_pubsub_subscribe_in_flight", float64(n), "topic", topic)
+
+	return start
+}
+
+// endProcessing records the messages of the topic, whose processing started at start, as processed, failed
+// being the errors of the messages which failed.
+func (s *SubscriptionManager) endProcessing(ctx context.Context, topic string, sub *subscription, n int, start time.Time,
+	failed ...error) {
+	s.container.Metrics().DeltaUpDownCounter(ctx, "app_pubsub_subscribe_in_flight", -float64(n), "topic", topic)
+	s.container.Metrics().RecordHistogram(ctx, "app_pubsub_subscribe_duration", time.Since(start).Seconds(), "topic", topic)
+
+	sub.status.mu.Lock()
+	defer sub.status.mu.Unlock()
+
+	sub.status.inFlight -= n
+	sub.status.processed += uint64(n - len(failed)) //nolint:gosec // the failed messages are among the n messages.
+	sub.status.failed += uint64(len(failed))
+
+	if len(failed) > 0 {
+		sub.status.lastErr = failed[len(failed)-1]
+		sub.status.lastErrAt = time.Now()
+	}
+}
+
+// health returns the status of the subscriptions by their topics, along with the number of subscriptions which
+// are down.
+func (s *SubscriptionManager) health() (subscriptions map[string]datasource.Health, downCount int) {
+	subscriptions = make(map[string]datasource.Health, len(s.subscriptions))
+
+	for topic, sub := range s.subscriptions {
+		health := sub.status.health()
+		if health.Status == datasource.StatusDown {
+			downCount++
+		}
+
+		subscriptions[topic] = health
+	}
+
+	return subscriptions, downCount
+}
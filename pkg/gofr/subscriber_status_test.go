@@ -0,0 +1,170 @@
+package gofr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/datasource"
+	"gofr.dev/pkg/gofr/datasource/pubsub"
+	gofrHTTP "gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/logging"
+	"gofr.dev/pkg/gofr/testutil"
+)
+
+var errRead = errors.New("broker unavailable")
+
+// expectProcessingMetrics expects the metrics of the messages of the topic processed by the subscriptions.
+func expectProcessingMetrics(mocks *container.Mocks, topic string) {
+	mocks.Metrics.EXPECT().DeltaUpDownCounter(gomock.Any(), "app_pubsub_subscribe_in_flight", gomock.Any(), "topic", topic).
+		AnyTimes()
+	mocks.Metrics.EXPECT().RecordHistogram(gomock.Any(), "app_pubsub_subscribe_duration", gomock.Any(), "topic", topic).
+		AnyTimes()
+}
+
+func TestSubscriptionManager_Processing(t *testing.T) {
+	c, mocks := container.NewMockContainer(t)
+
+	gomock.InOrder(
+		mocks.Metrics.EXPECT().DeltaUpDownCounter(gomock.Any(), "app_pubsub_subscribe_in_flight", 3.0, "topic", "orders"),
+		mocks.Metrics.EXPECT().DeltaUpDownCounter(gomock.Any(), "app_pubsub_subscribe_in_flight", -3.0, "topic", "orders"),
+	)
+	mocks.Metrics.EXPECT().RecordHistogram(gomock.Any(), "app_pubsub_subscribe_duration", gomock.Any(), "topic", "orders")
+
+	s := newSubscriptionManager(c)
+	sub := newSubscription(nil)
+
+	start := s.startProcessing(t.Context(), "orders", sub, 3)
+
+	assert.Equal(t, 3, sub.status.health().Details["in_flight"])
+
+	s.endProcessing(t.Context(), "orders", sub, 3, start, errHandler)
+
+	details := sub.status.health().Details
+
+	assert.Equal(t, 0, details["in_flight"])
+	assert.Equal(t, uint64(2), details["processed"])
+	assert.Equal(t, uint64(1), details["failed"])
+	assert.Equal(t, errHandler.Error(), details["last_error"])
+	assert.Equal(t, start, details["last_message_at"])
+}
+
+func TestSubscriptionStatus_Health(t *testing.T) {
+	status := &subscriptionStatus{}
+
+	health := status.health()
+	assert.Equal(t, statusStarting, health.Status, "the subscriber has not started")
+	assert.NotContains(t, health.Details, "last_message_at")
+	assert.NotContains(t, health.Details, "error")
+
+	status.setRunning(true)
+	assert.Equal(t, datasource.StatusUp, status.health().Status)
+
+	status.read(errRead)
+
+	health = status.health()
+	assert.Equal(t, datasource.StatusDown, health.Status)
+	assert.Equal(t, errRead.Error(), health.Details["error"])
+
+	// the subscription is up again once a message is read.
+	status.read(nil)
+	assert.Equal(t, datasource.StatusUp, status.health().Status)
+
+	status.setRunning(false)
+
+	health = status.health()
+	assert.Equal(t, datasource.StatusDown, health.Status, "the subscriber is stopped")
+	assert.Equal(t, "subscriber is not running", health.Details["error"])
+}
+
+func TestApp_healthHandler_Subscriptions(t *testing.T) {
+	testutil.NewServerConfigs(t)
+
+	app := New()
+	app.container = &container.Container{Logger: logging.NewLogger(logging.ERROR), PubSub: mockSubscriber{}}
+
+	app.Subscribe("orders", func(*Context) error { return nil })
+	app.Subscribe("payments", func(*Context) error { return nil })
+
+	for _, sub := range app.subscriptionManager.subscriptions {
+		sub.status.setRunning(true)
+	}
+
+	req, _ := http.NewRequestWithContext(t.Context(), http.MethodGet, "", http.NoBody)
+	ctx := newContext(nil, gofrHTTP.NewRequest(req), app.container)
+
+	h, err := app.healthHandler(ctx)
+	require.NoError(t, err)
+
+	health := h.(map[string]any)
+	subscriptions := health["subscriptions"].(map[string]datasource.Health)
+
+	assert.Len(t, subscriptions, 2)
+	assert.Equal(t, datasource.StatusUp, subscriptions["orders"].Status)
+	assert.Equal(t, "UP", health["status"])
+
+	app.subscriptionManager.subscriptions["payments"].status.read(errRead)
+
+	h, err = app.healthHandler(ctx)
+	require.NoError(t, err)
+
+	health = h.(map[string]any)
+	subscriptions = health["subscriptions"].(map[string]datasource.Health)
+
+	assert.Equal(t, datasource.StatusDown, subscriptions["payments"].Status)
+	assert.Equal(t, "DEGRADED", health["status"])
+}
+
+func TestApp_healthHandler_SubscriptionsStarting(t *testing.T) {
+	testutil.NewServerConfigs(t)
+
+	app := New()
+	app.container = &container.Container{Logger: logging.NewLogger(logging.ERROR), PubSub: mockSubscriber{}}
+
+	app.Subscribe("orders", func(*Context) error { return nil })
+	app.Subscribe("payments", func(*Context) error { return nil })
+
+	// the subscriber of orders has started, while the subscriber of payments is yet to start.
+	app.subscriptionManager.subscriptions["orders"].status.setRunning(true)
+
+	req, _ := http.NewRequestWithContext(t.Context(), http.MethodGet, "", http.NoBody)
+	ctx := newContext(nil, gofrHTTP.NewRequest(req), app.container)
+
+	h, err := app.healthHandler(ctx)
+	require.NoError(t, err)
+
+	health := h.(map[string]any)
+	subscriptions := health["subscriptions"].(map[string]datasource.Health)
+
+	assert.Equal(t, datasource.StatusUp, subscriptions["orders"].Status)
+	assert.Equal(t, statusStarting, subscriptions["payments"].Status)
+	assert.NotEqual(t, "DEGRADED", health["status"], "the app is degraded before its subscribers start")
+}
+
+func TestSubscriptionManager_startSubscriberStatus(t *testing.T) {
+	c, mocks := container.NewMockContainer(t)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	s := newSubscriptionManager(c)
+	sub := newSubscription(func(*Context) error { return nil })
+
+	mocks.PubSub.EXPECT().Subscribe(gomock.Any(), "orders").DoAndReturn(func(context.Context, string) (*pubsub.Message, error) {
+		assert.Equal(t, datasource.StatusUp, sub.status.health().Status, "the subscriber is running")
+
+		cancel()
+
+		return nil, errRead
+	})
+
+	require.NoError(t, s.startSubscriber(ctx, "orders", sub))
+
+	assert.Equal(t, datasource.StatusDown, sub.status.health().Status, "the subscriber is stopped")
+}
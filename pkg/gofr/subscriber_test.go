@@ -4,10 +4,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+
+	"gofr.dev/pkg/gofr/container"
 	"gofr.dev/pkg/gofr/datasource"
 	"gofr.dev/pkg/gofr/datasource/pubsub"
 	"gofr.dev/pkg/gofr/datasource/pubsub/kafka"
+	"gofr.dev/pkg/gofr/datasource/pubsub/memory"
 )
 
 var errSubscription = errors.New("subscription error")
@@ -39,6 +52,10 @@ func (mockSubscriber) Publish(_ context.Context, _ string, _ []byte) error {
 	return nil
 }
 
+func (mockSubscriber) PublishWithOptions(_ context.Context, _ string, _ []byte, _ pubsub.PublishOptions) error {
+	return nil
+}
+
 func (mockSubscriber) Subscribe(ctx context.Context, topic string) (*pubsub.Message, error) {
 	msg := pubsub.NewMessage(ctx)
 	msg.Topic = topic
@@ -56,3 +73,144 @@ func (mockSubscriber) Subscribe(ctx context.Context, topic string) (*pubsub.Mess
 func (mockSubscriber) Close() error {
 	return nil
 }
+
+func TestSubscriptionManager_handleSubscription_TraceContext(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	t.Cleanup(func() { otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator()) })
+
+	// the publisher injects the trace context of its span in the headers of the message.
+	publishCtx, publishSpan := otel.GetTracerProvider().Tracer("test").Start(t.Context(), "publish")
+	headers := pubsub.InjectTraceContext(publishCtx, map[string]string{"tenant": "gofr"})
+
+	publishSpan.End()
+
+	msg := pubsub.NewMessage(t.Context())
+	msg.Topic = "orders"
+	msg.SetHeaders(headers)
+
+	c, mocks := container.NewMockContainer(t)
+	expectProcessingMetrics(mocks, "orders")
+	mocks.PubSub.EXPECT().Subscribe(gomock.Any(), "orders").Return(msg, nil)
+
+	s := newSubscriptionManager(c)
+
+	var correlationID string
+
+	err := s.handleSubscription(t.Context(), "orders", newSubscription(func(c *Context) error {
+		correlationID = c.GetCorrelationID()
+
+		return nil
+	}))
+
+	require.NoError(t, err)
+	assert.Equal(t, publishSpan.SpanContext().TraceID().String(), correlationID)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "process orders", spans[1].Name)
+	assert.Equal(t, trace.SpanKindConsumer, spans[1].SpanKind)
+	assert.Equal(t, publishSpan.SpanContext().SpanID(), spans[1].Parent.SpanID())
+}
+
+func TestSubscriptionManager_MemoryPubSub(t *testing.T) {
+	c, mocks := container.NewMockContainer(t)
+	expectProcessingMetrics(mocks, "orders")
+
+	metrics := memory.NewMockMetrics(gomock.NewController(t))
+	metrics.EXPECT().IncrementCounter(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	client := memory.New(memory.Config{Broker: memory.NewBroker()}, c.Logger, metrics)
+	c.PubSub = client
+
+	require.NoError(t, client.Publish(t.Context(), "orders", []byte(`{"id":1}`)))
+	require.NoError(t, client.Publish(t.Context(), "orders", []byte(`{"id":2}`)))
+
+	ctx, cancel := context.WithCancel(t.Context())
+
+	var ids []int
+
+	s := newSubscriptionManager(c)
+
+	err := s.startSubscriber(ctx, "orders", newSubscription(func(c *Context) error {
+		var order struct {
+			ID int `json:"id"`
+		}
+
+		if err := c.Bind(&order); err != nil {
+			return err
+		}
+
+		ids = append(ids, order.ID)
+
+		if len(ids) == 2 {
+			cancel()
+		}
+
+		return nil
+	}))
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, ids)
+	assert.Equal(t, int64(2), client.Committed("orders"))
+}
+
+// prefixCodec decodes the message values into strings prefixed with the topic.
+type prefixCodec struct{}
+
+func (prefixCodec) ContentType() string { return "text/plain" }
+
+func (prefixCodec) Encode(_ context.Context, _ string, v any) ([]byte, error) {
+	return []byte(fmt.Sprint(v)), nil
+}
+
+func (prefixCodec) Decode(_ context.Context, topic string, data []byte, v any) error {
+	*v.(*string) = topic + ":" + string(data)
+
+	return nil
+}
+
+func TestSubscriptionManager_WithCodec(t *testing.T) {
+	c, mocks := container.NewMockContainer(t)
+	expectProcessingMetrics(mocks, "orders")
+
+	newMsg := func() *pubsub.Message {
+		msg := pubsub.NewMessage(t.Context())
+		msg.Topic = "orders"
+		msg.Value = []byte("order-1")
+
+		return msg
+	}
+
+	mocks.PubSub.EXPECT().Subscribe(gomock.Any(), "orders").Return(newMsg(), nil)
+
+	s := newSubscriptionManager(c)
+
+	var values []string
+
+	err := s.handleSubscription(t.Context(), "orders", newSubscription(func(c *Context) error {
+		var value string
+
+		err := c.Bind(&value)
+		values = append(values, value)
+
+		return err
+	}, WithCodec(prefixCodec{})))
+	require.NoError(t, err)
+
+	sub := newSubscription(nil, WithCodec(prefixCodec{}))
+	sub.batch = &batchSubscription{handler: func(_ *Context, messages []*pubsub.Message) error {
+		var value string
+
+		err := messages[0].Bind(&value)
+		values = append(values, value)
+
+		return err
+	}, maxSize: 1, maxWait: time.Second}
+
+	s.handleBatch(t.Context(), "orders", []*pubsub.Message{newMsg()}, sub)
+
+	assert.Equal(t, []string{"orders:order-1", "orders:order-1"}, values)
+}